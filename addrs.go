@@ -3,6 +3,7 @@ package core
 import (
 	"net"
 	"net/netip"
+	"strings"
 )
 
 // GetStringIPAddresses returns a list of text IP addresses bound
@@ -158,3 +159,63 @@ func ParseNetIP(s string) (ip net.IP, err error) {
 
 	return asNetIP(addr.Unmap()), nil
 }
+
+// ParsePrefix turns a string in CIDR notation into netip.Prefix.
+// A bare address is accepted as a single-host prefix.
+func ParsePrefix(s string) (netip.Prefix, error) {
+	if !strings.ContainsRune(s, '/') {
+		addr, err := ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, addrErr(s, "invalid prefix")
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, addrErr(s, "invalid prefix")
+	}
+	return p, nil
+}
+
+// AddrInPrefix tells if an address is contained in the given
+// CIDR prefix. IPv4-mapped IPv6 addresses are unmapped when
+// checking against IPv4 prefixes.
+func AddrInPrefix(addr netip.Addr, cidr string) (bool, error) {
+	p, err := ParsePrefix(cidr)
+	if err != nil {
+		return false, err
+	}
+
+	if p.Addr().Is4() {
+		addr = addr.Unmap()
+	}
+	return p.Contains(addr), nil
+}
+
+// AddrKind classifies an address for logging and policy decisions.
+// IPv4-mapped IPv6 addresses are unmapped first. It returns one of
+// "invalid", "unspecified", "loopback", "link-local", "multicast",
+// "private", "global" or "other".
+func AddrKind(addr netip.Addr) string {
+	addr = addr.Unmap()
+
+	switch {
+	case !addr.IsValid():
+		return "invalid"
+	case addr.IsUnspecified():
+		return "unspecified"
+	case addr.IsLoopback():
+		return "loopback"
+	case addr.IsLinkLocalUnicast(), addr.IsLinkLocalMulticast():
+		return "link-local"
+	case addr.IsMulticast():
+		return "multicast"
+	case addr.IsPrivate():
+		return "private"
+	case addr.IsGlobalUnicast():
+		return "global"
+	default:
+		return "other"
+	}
+}
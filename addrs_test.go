@@ -0,0 +1,75 @@
+package core
+
+import (
+	"net/netip"
+	"testing"
+)
+
+type addrInPrefixCase struct {
+	addr   string
+	cidr   string
+	expect bool
+	ok     bool
+}
+
+func TestAddrInPrefix(t *testing.T) {
+	var cases = []addrInPrefixCase{
+		{"10.1.2.3", "10.0.0.0/8", true, true},        // IPv4 in prefix              OK
+		{"11.1.2.3", "10.0.0.0/8", false, true},       // IPv4 not in prefix          OK
+		{"10.1.2.3", "10.1.2.3", true, true},          // IPv4 bare address           OK
+		{"10.1.2.4", "10.1.2.3", false, true},         // IPv4 other bare address     OK
+		{"::ffff:10.1.2.3", "10.0.0.0/8", true, true}, // IPv4-mapped in IPv4 prefix  OK
+		{"10.1.2.3", "::ffff:0:0/96", false, true},    // IPv4 in IPv6 prefix         OK
+		{"fe80::1", "fe80::/10", true, true},          // IPv6 link-local             OK
+		{"2001:db8::1", "fe80::/10", false, true},     // IPv6 not in prefix          OK
+		{"10.1.2.3", "10.0.0.0/33", false, false},     // bad prefix length           BAD
+		{"10.1.2.3", "10.0.0/8", false, false},        // bad prefix address          BAD
+		{"10.1.2.3", "", false, false},                // empty prefix                BAD
+		{"10.1.2.3", "example.org", false, false},     // name                        BAD
+	}
+
+	for _, d := range cases {
+		addr := netip.MustParseAddr(d.addr)
+		in, err := AddrInPrefix(addr, d.cidr)
+		if in != d.expect || (err == nil) != d.ok {
+			t.Errorf("%sAddrInPrefix(%q, %q) -> %v, %#v",
+				"FAIL ", d.addr, d.cidr, in, err)
+		} else {
+			t.Logf("%sAddrInPrefix(%q, %q) -> %v, %#v",
+				"", d.addr, d.cidr, in, err)
+		}
+	}
+}
+
+func TestAddrKind(t *testing.T) {
+	for _, tc := range []struct{ addr, kind string }{
+		{"0.0.0.0", "unspecified"},
+		{"::", "unspecified"},
+		{"127.0.0.1", "loopback"},
+		{"::1", "loopback"},
+		{"::ffff:127.0.0.1", "loopback"},
+		{"169.254.1.1", "link-local"},
+		{"fe80::1", "link-local"},
+		{"ff02::1", "link-local"},
+		{"224.0.0.1", "link-local"},
+		{"239.1.1.1", "multicast"},
+		{"ff05::2", "multicast"},
+		{"10.1.2.3", "private"},
+		{"192.168.1.1", "private"},
+		{"::ffff:192.168.1.1", "private"},
+		{"fd00::1", "private"},
+		{"8.8.8.8", "global"},
+		{"::ffff:8.8.8.8", "global"},
+		{"2001:4860:4860::8888", "global"},
+		{"255.255.255.255", "other"},
+	} {
+		addr := netip.MustParseAddr(tc.addr)
+		if s := AddrKind(addr); s != tc.kind {
+			t.Errorf("AddrKind(%q) → %q (expected %q)", tc.addr, s, tc.kind)
+		}
+	}
+
+	if s := AddrKind(netip.Addr{}); s != "invalid" {
+		t.Errorf("AddrKind(%q) → %q (expected %q)", "", s, "invalid")
+	}
+}
@@ -6,6 +6,13 @@ func As[T, V any](v T) (V, bool) {
 	return x, ok
 }
 
+// TryT attempts to cast a value to the given type, returning false
+// instead of panicking if it can't. A nil interface never succeeds,
+// while a typed nil succeeds when the type matches.
+func TryT[T any](v any) (T, bool) {
+	return As[any, T](v)
+}
+
 // AsFn returns a value cast to a different type using a helper function
 func AsFn[T, V any](fn func(T) (V, bool), v T) (V, bool) {
 	if fn == nil {
@@ -0,0 +1,45 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+)
+
+type tryTCase struct {
+	name   string
+	try    func() (any, bool)
+	expect any
+	ok     bool
+}
+
+func newTryTCase[T any](name string, v any, expect any, ok bool) tryTCase {
+	return tryTCase{
+		name:   name,
+		try:    func() (any, bool) { return TryT[T](v) },
+		expect: expect,
+		ok:     ok,
+	}
+}
+
+func TestTryT(t *testing.T) {
+	var err error = ErrInvalid
+	var p *PanicError
+
+	for _, tc := range []tryTCase{
+		newTryTCase[int]("TryT[int](42)", 42, 42, true),
+		newTryTCase[string]("TryT[string](42)", 42, "", false),
+		// interfaces
+		newTryTCase[error]("TryT[error](ErrInvalid)", err, err, true),
+		newTryTCase[fmt.Stringer]("TryT[fmt.Stringer](ErrInvalid)", err, nil, false),
+		// nil interface
+		newTryTCase[error]("TryT[error](nil)", nil, nil, false),
+		// typed nil
+		newTryTCase[*PanicError]("TryT[*PanicError](nil)", p, p, true),
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if v, ok := tc.try(); ok != tc.ok || v != tc.expect {
+				t.Errorf("%s → %v, %v (expected %v, %v)", tc.name, v, ok, tc.expect, tc.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package core
+
+// Drain receives all the values currently available on a channel,
+// without blocking, and returns them.
+// It doesn't wait for the channel to be closed.
+func Drain[T any](ch <-chan T) []T {
+	return DrainN(ch, -1)
+}
+
+// DrainN receives up to n values currently available on a channel,
+// without blocking, and returns them. If n is negative there is no
+// limit.
+// It doesn't wait for the channel to be closed.
+func DrainN[T any](ch <-chan T, n int) []T {
+	var out []T
+
+	for n < 0 || len(out) < n {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				// closed
+				return out
+			}
+			out = append(out, v)
+		default:
+			// would block
+			return out
+		}
+	}
+	return out
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestDrain(t *testing.T) {
+	ch := make(chan int, 8)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+
+	if s := DrainN(ch, 2); !SliceEqual(s, S(1, 2)) {
+		t.Errorf("DrainN(2) → %v", s)
+	}
+	if s := DrainN(ch, 0); len(s) != 0 {
+		t.Errorf("DrainN(0) → %v", s)
+	}
+	if s := Drain(ch); !SliceEqual(s, S(3, 4, 5)) {
+		t.Errorf("Drain → %v", s)
+	}
+
+	// empty, doesn't block
+	if s := Drain(ch); len(s) != 0 {
+		t.Errorf("Drain(empty) → %v", s)
+	}
+
+	// closed
+	ch <- 6
+	close(ch)
+	if s := Drain(ch); !SliceEqual(s, S(6)) {
+		t.Errorf("Drain(closed) → %v", s)
+	}
+
+	// nil channel, doesn't block
+	if s := Drain[int](nil); len(s) != 0 {
+		t.Errorf("Drain(nil) → %v", s)
+	}
+}
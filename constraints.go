@@ -25,6 +25,13 @@ type Float interface {
 	~float32 | ~float64
 }
 
+// Number is any integer or floating-point type, supporting
+// both arithmetic and ordering operators. Use [Complex] explicitly
+// for complex numbers.
+type Number interface {
+	Integer | Float
+}
+
 // Bool is any boolean type.
 type Bool interface {
 	~bool
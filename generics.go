@@ -15,6 +15,9 @@ func Coalesce[T any](opts ...T) T {
 
 // IIf returns one value or the other depending
 // on a condition.
+// Both values are evaluated before the call, so any side
+// effects happen regardless of the condition. Use [IIfFn]
+// for lazy evaluation.
 func IIf[T any](cond bool, yes, no T) T {
 	// revive:enable:flag-parameter
 	if cond {
@@ -22,3 +25,18 @@ func IIf[T any](cond bool, yes, no T) T {
 	}
 	return no
 }
+
+// revive:disable:flag-parameter
+
+// IIfFn calls one function or the other depending
+// on a condition, and returns its value. A nil function
+// produces the zero value.
+func IIfFn[T any](cond bool, yes, no func() T) T {
+	// revive:enable:flag-parameter
+	fn := IIf(cond, yes, no)
+	if fn == nil {
+		var zero T
+		return zero
+	}
+	return fn()
+}
@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestIIfFn(t *testing.T) {
+	var calls []string
+
+	fn := func(s string) func() string {
+		return func() string {
+			calls = append(calls, s)
+			return s
+		}
+	}
+
+	if s := IIfFn(true, fn("yes"), fn("no")); s != "yes" {
+		t.Errorf("IIfFn(true) → %q", s)
+	}
+	if s := IIfFn(false, fn("yes"), fn("no")); s != "no" {
+		t.Errorf("IIfFn(false) → %q", s)
+	}
+	if !SliceEqual(calls, S("yes", "no")) {
+		t.Errorf("IIfFn: unexpected calls %q", calls)
+	}
+
+	if s := IIfFn(true, nil, fn("no")); s != "" {
+		t.Errorf("IIfFn(true, nil) → %q", s)
+	}
+}
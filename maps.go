@@ -13,6 +13,15 @@ func Keys[K comparable, T any](m map[K]T) []K {
 	return out
 }
 
+// Values returns the list of values of a map, in no particular order
+func Values[K comparable, T any](m map[K]T) []T {
+	out := make([]T, 0, len(m))
+	for _, v := range m {
+		out = append(out, v)
+	}
+	return out
+}
+
 // SortedKeys returns a sorted list of the keys of a map
 func SortedKeys[K Ordered, T any](m map[K]T) []K {
 	keys := Keys(m)
@@ -29,6 +38,32 @@ func SortedKeys[K Ordered, T any](m map[K]T) []K {
 	return keys
 }
 
+// MapKeysCond returns the list of keys of a map whose entries
+// satisfy the given condition, in no particular order.
+// A nil map returns nil, and if no condition is given all keys
+// are returned.
+func MapKeysCond[K comparable, V any](m map[K]V, cond func(K, V) bool) []K {
+	if m == nil {
+		return nil
+	}
+
+	out := make([]K, 0, len(m))
+	for k, v := range m {
+		if cond == nil || cond(k, v) {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// SortedKeysCond returns a sorted list of the keys of a map
+// whose entries satisfy the given condition.
+func SortedKeysCond[K Ordered, V any](m map[K]V, cond func(K, V) bool) []K {
+	keys := MapKeysCond(m, cond)
+	SliceSortOrdered(keys)
+	return keys
+}
+
 // MapValue returns a value of an entry or a default if
 // not found
 func MapValue[K comparable, V any](m map[K]V, key K, def V) (V, bool) {
@@ -38,6 +73,67 @@ func MapValue[K comparable, V any](m map[K]V, key K, def V) (V, bool) {
 	return def, false
 }
 
+// MapGetOrSet returns the value of an entry, or stores and returns a new
+// one produced by the given function if not found. A nil function
+// stores the zero value.
+// The map must not be nil, and the operation isn't safe for concurrent
+// use.
+func MapGetOrSet[K comparable, V any](m map[K]V, key K, makeValue func() V) V {
+	if val, ok := m[key]; ok {
+		return val
+	}
+
+	var val V
+	if makeValue != nil {
+		val = makeValue()
+	}
+	m[key] = val
+	return val
+}
+
+// MapFilter returns a new map containing only the entries
+// satisfying the given condition. A nil map returns nil, while
+// a non-nil map always produces a non-nil result even if empty.
+// If no condition is given the result is a shallow copy.
+func MapFilter[K comparable, V any](m map[K]V, keep func(K, V) bool) map[K]V {
+	if m == nil {
+		return nil
+	}
+
+	out := make(map[K]V)
+	for k, v := range m {
+		if keep == nil || keep(k, v) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// MapMerge copies all entries of src into dst, overwriting
+// existing keys. Merging into a nil map is a no-op.
+func MapMerge[K comparable, V any](dst, src map[K]V) {
+	MapMergeFn(dst, src, nil)
+}
+
+// MapMergeFn copies all entries of src into dst, using the given
+// function to resolve the value of keys already present on dst.
+// If no function is given src values overwrite existing ones.
+// Merging into a nil map is a no-op.
+func MapMergeFn[K comparable, V any](dst, src map[K]V,
+	resolve func(k K, old, value V) V) {
+	//
+	if dst == nil {
+		return
+	}
+
+	for k, v := range src {
+		if old, ok := dst[k]; ok && resolve != nil {
+			v = resolve(k, old, v)
+		}
+		dst[k] = v
+	}
+}
+
 // MapContains tells if a given map contains a key.
 // this helper is intended for switch/case conditions
 func MapContains[K comparable](m map[K]any, key K) bool {
@@ -52,11 +148,12 @@ func MapListContains[K comparable, T comparable](m map[K]*list.List, key K, v T)
 	})
 }
 
-// MapListContainsFn checks if the list.List on a map contains an element using a match functions
+// MapListContainsFn checks if the list.List on a map contains an element using a match functions.
+// A nil map, a nil eq, a missing key or an empty list never contain the element.
 func MapListContainsFn[K comparable, T any](m map[K]*list.List, key K, v T,
 	eq func(T, T) bool) bool {
 	//
-	if m != nil && eq == nil {
+	if m != nil && eq != nil {
 		if l, ok := m[key]; ok {
 			return ListContainsFn(l, v, eq)
 		}
@@ -90,6 +187,20 @@ func MapListForEachElement[K comparable](m map[K]*list.List, key K,
 	}
 }
 
+// MapListForEachBackward calls a function for each value on a map entry,
+// from the back of the list, until told to stop
+func MapListForEachBackward[K comparable, T any](m map[K]*list.List, key K,
+	fn func(v T) bool) {
+	//
+	if m == nil || fn == nil {
+		return
+	}
+
+	if l, ok := m[key]; ok {
+		ListForEachBackward(l, fn)
+	}
+}
+
 // MapListInsert adds a value at the front of the list of a map entry
 func MapListInsert[K comparable, T any](m map[K]*list.List, key K, v T) {
 	getMapList(m, key).PushFront(v)
@@ -154,6 +265,54 @@ func MapListAppendUniqueFn[K comparable, T any](m map[K]*list.List, key K, v T,
 	}
 }
 
+// MapListDeleteKey removes the list of a map entry
+func MapListDeleteKey[K comparable](m map[K]*list.List, key K) {
+	if m != nil {
+		delete(m, key)
+	}
+}
+
+// MapListClear empties the list of a map entry, but keeps
+// the entry
+func MapListClear[K comparable](m map[K]*list.List, key K) {
+	if l, ok := m[key]; ok && l != nil {
+		l.Init()
+	}
+}
+
+// MapListLen returns the length of the list of a map entry,
+// or zero if there is none
+func MapListLen[K comparable](m map[K]*list.List, key K) int {
+	if l, ok := m[key]; ok && l != nil {
+		return l.Len()
+	}
+	return 0
+}
+
+// MapListDelete removes the first element matching the given value
+// from the list of a map entry, and removes the entry if the list
+// becomes empty. It reports whether the value was found.
+func MapListDelete[K comparable, T comparable](m map[K]*list.List, key K, v T) bool {
+	l, ok := m[key]
+	if !ok || l == nil {
+		return false
+	}
+
+	var found bool
+	ListForEachElement(l, func(el *list.Element) bool {
+		if va, ok := el.Value.(T); ok && va == v {
+			l.Remove(el)
+			found = true
+		}
+		return found
+	})
+
+	if found && l.Len() == 0 {
+		delete(m, key)
+	}
+	return found
+}
+
 // MapListCopy duplicates a map containing a list.List
 func MapListCopy[T comparable](src map[T]*list.List) map[T]*list.List {
 	fn := func(v any) (any, bool) { return v, true }
@@ -223,3 +382,24 @@ func MapAllListForEachElement[K comparable](m map[K]*list.List, fn func(*list.El
 		}
 	}
 }
+
+// MapAllListForEachBackward calls a function for each value on all map entries,
+// from the back of each list, until told to stop
+func MapAllListForEachBackward[K comparable, T any](m map[K]*list.List, fn func(v T) bool) {
+	var term bool
+
+	if fn == nil {
+		return
+	}
+
+	for _, l := range m {
+		ListForEachBackward(l, func(v T) bool {
+			term = fn(v)
+			return term
+		})
+
+		if term {
+			break
+		}
+	}
+}
@@ -0,0 +1,280 @@
+package core
+
+import (
+	"container/list"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	if v := Values[string, int](nil); len(v) != 0 {
+		t.Errorf("Values(nil) → %v", v)
+	}
+
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	v := Values(m)
+	SliceSortOrdered(v)
+	if !SliceEqual(v, S(1, 2, 3)) {
+		t.Errorf("Values(%v) → %v", m, v)
+	}
+}
+
+func TestMapListClearDeleteKey(t *testing.T) {
+	m := make(map[string]*list.List)
+	MapListAppend(m, "a", 1)
+	MapListAppend(m, "a", 2)
+	MapListAppend(m, "b", 3)
+
+	MapListClear(m, "a")
+	if l, ok := m["a"]; !ok || l.Len() != 0 {
+		t.Errorf("MapListClear: %q not emptied", "a")
+	}
+
+	MapListDeleteKey(m, "b")
+	if _, ok := m["b"]; ok {
+		t.Errorf("MapListDeleteKey: %q not removed", "b")
+	}
+
+	// missing keys
+	MapListClear(m, "c")
+	MapListDeleteKey(m, "c")
+	if len(m) != 1 {
+		t.Errorf("MapListClear/MapListDeleteKey: unexpected map %v", m)
+	}
+
+	// nil maps
+	MapListClear[string](nil, "a")
+	MapListDeleteKey[string](nil, "a")
+}
+
+func TestMapListContainsFn(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+
+	m := make(map[string]*list.List)
+	MapListAppend(m, "a", 1)
+	MapListAppend(m, "a", 2)
+	m["empty"] = list.New()
+
+	for _, tc := range []struct {
+		name   string
+		m      map[string]*list.List
+		key    string
+		v      int
+		eq     func(int, int) bool
+		expect bool
+	}{
+		{"nil map", nil, "a", 1, eq, false},
+		{"nil eq", m, "a", 1, nil, false},
+		{"missing key", m, "b", 1, eq, false},
+		{"empty list", m, "empty", 1, eq, false},
+		{"first", m, "a", 1, eq, true},
+		{"second", m, "a", 2, eq, true},
+		{"absent", m, "a", 3, eq, false},
+	} {
+		if r := MapListContainsFn(tc.m, tc.key, tc.v, tc.eq); r != tc.expect {
+			t.Errorf("MapListContainsFn: %s → %v (expected %v)", tc.name, r, tc.expect)
+		}
+	}
+
+	if !MapListContains(m, "a", 2) {
+		t.Errorf("MapListContains: %v not found", 2)
+	}
+}
+
+func TestMapGetOrSet(t *testing.T) {
+	var calls int
+	makeValue := func() int {
+		calls++
+		return 42
+	}
+
+	m := map[string]int{"a": 1}
+	if v := MapGetOrSet(m, "a", makeValue); v != 1 || calls != 0 {
+		t.Errorf("MapGetOrSet: existing → %v (%v calls)", v, calls)
+	}
+	if v := MapGetOrSet(m, "b", makeValue); v != 42 || calls != 1 || m["b"] != 42 {
+		t.Errorf("MapGetOrSet: new → %v (%v calls)", v, calls)
+	}
+	if v := MapGetOrSet(m, "b", makeValue); v != 42 || calls != 1 {
+		t.Errorf("MapGetOrSet: stored → %v (%v calls)", v, calls)
+	}
+}
+
+func TestMapGetOrSetNil(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if v := MapGetOrSet(m, "c", nil); v != 0 {
+		t.Errorf("MapGetOrSet: nil function → %v", v)
+	}
+	if _, ok := m["c"]; !ok {
+		t.Errorf("MapGetOrSet: nil function didn't store")
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	odd := func(_ string, v int) bool { return v%2 != 0 }
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	r := MapFilter(m, odd)
+	if len(r) != 2 || r["a"] != 1 || r["c"] != 3 {
+		t.Errorf("MapFilter: %v → %v", m, r)
+	}
+	if len(m) != 3 {
+		t.Errorf("MapFilter: modified its input %v", m)
+	}
+
+	if r := MapFilter(map[string]int{"b": 2}, odd); r == nil || len(r) != 0 {
+		t.Errorf("MapFilter: no matches → %#v", r)
+	}
+	if r := MapFilter(nil, odd); r != nil {
+		t.Errorf("MapFilter: nil map → %#v", r)
+	}
+	if r := MapFilter(m, nil); len(r) != len(m) {
+		t.Errorf("MapFilter: nil condition → %v", r)
+	}
+}
+
+func TestMapMerge(t *testing.T) {
+	dst := map[string]int{"a": 1, "b": 2}
+	MapMerge(dst, map[string]int{"b": 20, "c": 30})
+	if len(dst) != 3 || dst["a"] != 1 || dst["b"] != 20 || dst["c"] != 30 {
+		t.Errorf("MapMerge: → %v", dst)
+	}
+
+	sum := func(_ string, old, v int) int { return old + v }
+	dst = map[string]int{"a": 1, "b": 2}
+	MapMergeFn(dst, map[string]int{"b": 20, "c": 30}, sum)
+	if len(dst) != 3 || dst["a"] != 1 || dst["b"] != 22 || dst["c"] != 30 {
+		t.Errorf("MapMergeFn: → %v", dst)
+	}
+
+	// nil maps
+	MapMerge(dst, nil)
+	MapMerge(nil, dst)
+	MapMergeFn(nil, dst, sum)
+	if len(dst) != 3 {
+		t.Errorf("MapMerge: nil source → %v", dst)
+	}
+}
+
+func TestMapKeysCond(t *testing.T) {
+	odd := func(_ string, v int) bool { return v%2 != 0 }
+	m := map[string]int{"d": 7, "a": 1, "b": 2, "c": 3}
+
+	keys := MapKeysCond(m, odd)
+	SliceSortOrdered(keys)
+	if !SliceEqual(keys, S("a", "c", "d")) {
+		t.Errorf("MapKeysCond: %v → %v", m, keys)
+	}
+	if keys := SortedKeysCond(m, odd); !SliceEqual(keys, S("a", "c", "d")) {
+		t.Errorf("SortedKeysCond: %v → %v", m, keys)
+	}
+	if keys := SortedKeysCond(m, nil); !SliceEqual(keys, S("a", "b", "c", "d")) {
+		t.Errorf("SortedKeysCond: nil condition → %v", keys)
+	}
+
+	if keys := MapKeysCond(nil, odd); keys != nil {
+		t.Errorf("MapKeysCond: nil map → %#v", keys)
+	}
+	if keys := SortedKeysCond(nil, odd); keys != nil {
+		t.Errorf("SortedKeysCond: nil map → %#v", keys)
+	}
+}
+
+func TestMapListForEachBackward(t *testing.T) {
+	m := make(map[string]*list.List)
+	MapListInsert(m, "a", 1)
+	MapListInsert(m, "a", 2)
+	MapListInsert(m, "a", 3)
+	MapListInsert(m, "b", 4)
+
+	var out []int
+	collect := func(v int) bool {
+		out = append(out, v)
+		return false
+	}
+
+	MapListForEachBackward(m, "a", collect)
+	if !SliceEqual(out, S(1, 2, 3)) {
+		t.Errorf("MapListForEachBackward: → %v", out)
+	}
+
+	out = nil
+	MapListForEachBackward(m, "a", func(v int) bool {
+		out = append(out, v)
+		return v == 2
+	})
+	if !SliceEqual(out, S(1, 2)) {
+		t.Errorf("MapListForEachBackward: stop → %v", out)
+	}
+
+	out = nil
+	MapAllListForEachBackward(m, collect)
+	SliceSortOrdered(out)
+	if !SliceEqual(out, S(1, 2, 3, 4)) {
+		t.Errorf("MapAllListForEachBackward: → %v", out)
+	}
+
+	out = nil
+	MapAllListForEachBackward(m, func(v int) bool {
+		out = append(out, v)
+		return true
+	})
+	if len(out) != 1 {
+		t.Errorf("MapAllListForEachBackward: stop → %v", out)
+	}
+
+	// nil maps and functions
+	MapListForEachBackward[string](nil, "a", collect)
+	MapListForEachBackward[string, int](m, "a", nil)
+	MapAllListForEachBackward[string](nil, collect)
+	MapAllListForEachBackward[string, int](m, nil)
+}
+
+func TestMapListDelete(t *testing.T) {
+	m := make(map[string]*list.List)
+	MapListAppend(m, "a", 1)
+	MapListAppend(m, "a", 2)
+	MapListAppend(m, "a", 1)
+	MapListAppend(m, "b", 3)
+
+	checkMapListValues(t, m, "a", 1, 2, 1)
+
+	if !MapListDelete(m, "a", 1) {
+		t.Errorf("MapListDelete: %q, %v not removed", "a", 1)
+	}
+	checkMapListValues(t, m, "a", 2, 1)
+
+	if MapListDelete(m, "a", 3) || MapListDelete(m, "c", 3) {
+		t.Errorf("MapListDelete: removed missing value")
+	}
+
+	if !MapListDelete(m, "b", 3) {
+		t.Errorf("MapListDelete: %q, %v not removed", "b", 3)
+	}
+	if _, ok := m["b"]; ok {
+		t.Errorf("MapListDelete: empty %q entry not removed", "b")
+	}
+	checkMapListValues(t, m, "b")
+}
+
+func TestMapListDeleteNil(t *testing.T) {
+	if n := MapListLen[string](nil, "a"); n != 0 {
+		t.Errorf("MapListLen: nil map → %v", n)
+	}
+	if MapListDelete[string](nil, "a", 1) {
+		t.Errorf("MapListDelete: nil map removed something")
+	}
+}
+
+// checkMapListValues checks the length and values of
+// the list of a map entry
+func checkMapListValues(t *testing.T, m map[string]*list.List, key string, want ...int) {
+	var out []int
+	MapListForEach(m, key, func(v int) bool {
+		out = append(out, v)
+		return false
+	})
+
+	if n := MapListLen(m, key); n != len(want) || !SliceEqual(out, want) {
+		t.Errorf("MapList: %q → %v, %v (expected %v)", key, n, out, want)
+	}
+}
@@ -0,0 +1,25 @@
+package core
+
+import "reflect"
+
+// Mustf returns the value if there is no error, otherwise
+// it panics with a [PanicError] wrapping the error annotated
+// with the formatted note.
+func Mustf[T any](v T, err error, format string, args ...any) T {
+	if err != nil {
+		panic(NewPanicWrapf(1, err, format, args...))
+	}
+	return v
+}
+
+// MustImplement returns the value as the interface I, or panics
+// with a [PanicError] describing the mismatch if it doesn't
+// implement it.
+func MustImplement[I any](v any) I {
+	x, ok := v.(I)
+	if !ok {
+		iface := reflect.TypeOf((*I)(nil)).Elem()
+		panic(NewPanicWrapf(1, ErrInvalid, "%T does not implement %s", v, iface))
+	}
+	return x
+}
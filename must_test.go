@@ -0,0 +1,57 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMustf(t *testing.T) {
+	if v := Mustf(42, nil, "unused %v", 1); v != 42 {
+		t.Errorf("Mustf: expected %v, got %v", 42, v)
+	}
+
+	err := Catch(func() error {
+		_ = Mustf(0, ErrInvalid, "loading %q", "config.toml")
+		return nil
+	})
+
+	p, ok := err.(*PanicError)
+	switch {
+	case !ok:
+		t.Fatalf("Mustf: expected *PanicError, got %#v", err)
+	case !errors.Is(err, ErrInvalid):
+		t.Errorf("Mustf: %v doesn't wrap %v", err, ErrInvalid)
+	case p.Error() != `panic: loading "config.toml": invalid argument`:
+		t.Errorf("Mustf: unexpected message %q", p.Error())
+	}
+}
+
+func TestMustImplement(t *testing.T) {
+	var v any = &PanicError{}
+
+	if err := MustImplement[error](v); err != v {
+		t.Errorf("MustImplement: expected %v, got %v", v, err)
+	}
+	if s := MustImplement[CallStacker](v); s != v {
+		t.Errorf("MustImplement: expected %v, got %v", v, s)
+	}
+
+	for _, v := range []any{nil, 42, "str"} {
+		err := Catch(func() error {
+			_ = MustImplement[fmt.Stringer](v)
+			return nil
+		})
+
+		_, ok := err.(*PanicError)
+		switch {
+		case !ok:
+			t.Errorf("MustImplement(%#v): expected *PanicError, got %#v", v, err)
+		case !errors.Is(err, ErrInvalid):
+			t.Errorf("MustImplement(%#v): %v doesn't wrap %v", v, err, ErrInvalid)
+		case !strings.Contains(err.Error(), "does not implement fmt.Stringer"):
+			t.Errorf("MustImplement(%#v): unexpected message %q", v, err)
+		}
+	}
+}
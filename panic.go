@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"sync/atomic"
 )
 
@@ -27,9 +28,39 @@ func AsRecovered(rvr any) Recovered {
 	return NewPanicError(2, rvr)
 }
 
+// Recover is meant to be deferred directly inside functions with
+// a named error return, i.e. `defer core.Recover(&err)`, to convert
+// a panic into a [Recovered] error.
+// The panic is only stored if *errp is nil, so organic errors
+// aren't overwritten. If errp is nil the panic is discarded.
+func Recover(errp *error) {
+	if err := AsRecovered(recover()); err != nil {
+		if errp != nil && *errp == nil {
+			*errp = err
+		}
+	}
+}
+
+// RecoverWith is meant to be deferred directly, i.e.
+// `defer core.RecoverWith(handler)`, to pass a panic converted into
+// a [Recovered] error to the given handler.
+// The handler is only called if there was a panic, and the panic is
+// always swallowed, even if the handler is nil.
+func RecoverWith(fn func(Recovered)) {
+	if err := AsRecovered(recover()); err != nil && fn != nil {
+		fn(err)
+	}
+}
+
 // Catcher is a runner that catches panics
 type Catcher struct {
-	recovered atomic.Value
+	recovered atomic.Value // *caught
+}
+
+// caught is the first event stored by a [Catcher]
+type caught struct {
+	err       Recovered
+	cancelled bool
 }
 
 // Do calls a function, returning its organic error,
@@ -55,7 +86,7 @@ func (p *Catcher) Try(fn func() error) error {
 	if fn != nil {
 		defer func() {
 			if err := AsRecovered(recover()); err != nil {
-				p.recovered.CompareAndSwap(nil, err)
+				p.store(err, false)
 			}
 		}()
 
@@ -64,18 +95,104 @@ func (p *Catcher) Try(fn func() error) error {
 	return nil
 }
 
+// TryCtx calls a function like [Catcher.Try], but once the context
+// is cancelled new panics are no longer stored, and instead the
+// cancellation cause is recorded as a [Recovered] error for later
+// consumption. [Catcher.Cancelled] tells if the stored error came
+// from a cancellation rather than an actual panic.
+// If the context is already cancelled the function isn't called,
+// nothing is stored, and ctx.Err() is returned.
+func (p *Catcher) TryCtx(ctx context.Context, fn func() error) error {
+	switch {
+	case ctx == nil:
+		return p.Try(fn)
+	case fn == nil:
+		return nil
+	case ctx.Err() != nil:
+		return ctx.Err()
+	}
+
+	defer func() {
+		if err := AsRecovered(recover()); err != nil {
+			if ctx.Err() != nil {
+				p.storeCancelled(ctx)
+			} else {
+				p.store(err, false)
+			}
+		}
+	}()
+
+	return fn()
+}
+
+func (p *Catcher) storeCancelled(ctx context.Context) {
+	p.store(NewPanicError(2, context.Cause(ctx)), true)
+}
+
+func (p *Catcher) store(err Recovered, cancelled bool) {
+	p.recovered.CompareAndSwap(nil, &caught{
+		err:       err,
+		cancelled: cancelled,
+	})
+}
+
 // Recovered returns the error corresponding to a
 // panic when the Catcher was running a function
 func (p *Catcher) Recovered() Recovered {
-	if err, ok := p.recovered.Load().(Recovered); ok {
-		return err
+	if c, ok := p.recovered.Load().(*caught); ok {
+		return c.err
 	}
 	return nil
 }
 
+// Cancelled tells if the stored [Recovered] error corresponds
+// to a context cancellation recorded by [Catcher.TryCtx] instead
+// of an actual panic.
+func (p *Catcher) Cancelled() bool {
+	if c, ok := p.recovered.Load().(*caught); ok {
+		return c.cancelled
+	}
+	return false
+}
+
 // Catch uses a [Catcher] to safely call a function and
 // return the organic error or the [Recovered] [PanicError].
 func Catch(fn func() error) error {
 	var p Catcher
 	return p.Do(fn)
 }
+
+// CatchValue uses a [Catcher] to safely call a function returning
+// a value and an error, and returns them, or the zero value and
+// the [Recovered] [PanicError] if it panicked.
+func CatchValue[T any](fn func() (T, error)) (v T, err error) {
+	if fn == nil {
+		return v, nil
+	}
+
+	var p Catcher
+	_ = p.Try(func() error {
+		v, err = fn()
+		return nil
+	})
+
+	if perr := p.Recovered(); perr != nil {
+		// v wasn't assigned if fn panicked
+		return v, perr
+	}
+	return v, err
+}
+
+// CatchFunc uses a [Catcher] to safely call a function without
+// return values, and returns the [Recovered] [PanicError] if it
+// panicked.
+func CatchFunc(fn func()) error {
+	if fn == nil {
+		return nil
+	}
+
+	return Catch(func() error {
+		fn()
+		return nil
+	})
+}
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+var errTestOrganic = errors.New("organic")
+
+func recoverCase(organic error, payload any) (err error) {
+	defer Recover(&err)
+
+	err = organic
+	if payload != nil {
+		panic(payload)
+	}
+	return err
+}
+
+func TestRecover(t *testing.T) {
+	// no panic
+	if err := recoverCase(nil, nil); err != nil {
+		t.Errorf("Recover: unexpected error %v", err)
+	}
+
+	// organic error
+	if err := recoverCase(errTestOrganic, nil); err != errTestOrganic {
+		t.Errorf("Recover: expected %v, got %v", errTestOrganic, err)
+	}
+
+	// panic
+	err := recoverCase(nil, "oops")
+	if p, ok := err.(*PanicError); !ok {
+		t.Errorf("Recover: expected *PanicError, got %#v", err)
+	} else if len(p.CallStack()) == 0 {
+		t.Errorf("Recover: missing call stack")
+	}
+
+	// panic doesn't clobber an existing error
+	if err := recoverCase(errTestOrganic, "oops"); err != errTestOrganic {
+		t.Errorf("Recover: expected %v, got %v", errTestOrganic, err)
+	}
+}
+
+func TestRecoverNil(t *testing.T) {
+	defer func() {
+		if rvr := recover(); rvr != nil {
+			t.Errorf("Recover(nil): panic escaped: %v", rvr)
+		}
+	}()
+
+	func() {
+		defer Recover(nil)
+		panic("oops")
+	}()
+}
+
+func TestRecoverWith(t *testing.T) {
+	var calls int
+	var caught Recovered
+
+	handler := func(err Recovered) {
+		calls++
+		caught = err
+	}
+
+	// no panic
+	func() {
+		defer RecoverWith(handler)
+	}()
+
+	if calls != 0 {
+		t.Fatalf("RecoverWith: handler called without panic")
+	}
+
+	// panic
+	func() {
+		defer RecoverWith(handler)
+		panic(errTestOrganic)
+	}()
+
+	switch {
+	case calls != 1:
+		t.Fatalf("RecoverWith: handler called %v times", calls)
+	case caught == nil:
+		t.Fatalf("RecoverWith: nil Recovered")
+	case !errors.Is(caught, errTestOrganic):
+		t.Errorf("RecoverWith: expected %v, got %v", errTestOrganic, caught)
+	}
+
+	// nil handler
+	func() {
+		defer RecoverWith(nil)
+		panic("oops")
+	}()
+}
+
+func TestCatcherTryCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// organic error, nothing stored
+	var p Catcher
+	if err := p.TryCtx(ctx, func() error { return errTestOrganic }); err != errTestOrganic {
+		t.Errorf("TryCtx: expected %v, got %v", errTestOrganic, err)
+	}
+	if p.Recovered() != nil || p.Cancelled() {
+		t.Errorf("TryCtx: unexpected recovered %v", p.Recovered())
+	}
+
+	// panic before cancellation
+	_ = p.TryCtx(ctx, func() error { panic(errTestOrganic) })
+	if err := p.Recovered(); !errors.Is(err, errTestOrganic) || p.Cancelled() {
+		t.Errorf("TryCtx: expected %v, got %v (cancelled:%v)", errTestOrganic, err, p.Cancelled())
+	}
+}
+
+func TestCatcherTryCtxPanicCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var p Catcher
+	_ = p.TryCtx(ctx, func() error {
+		cancel()
+		panic(errTestOrganic)
+	})
+	if err := p.Recovered(); !errors.Is(err, context.Canceled) || !p.Cancelled() {
+		t.Errorf("TryCtx: expected %v, got %v (cancelled:%v)", context.Canceled, err, p.Cancelled())
+	}
+}
+
+func TestCatcherTryCtxCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var p Catcher
+	var called bool
+	err := p.TryCtx(ctx, func() error { called = true; return nil })
+	if err != context.Canceled || called {
+		t.Errorf("TryCtx: expected %v, got %v (called:%v)", context.Canceled, err, called)
+	}
+	if p.Recovered() != nil || p.Cancelled() {
+		t.Errorf("TryCtx: unexpected recovered %v", p.Recovered())
+	}
+}
+
+func TestCatchErrorsIs(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		payload any
+		expect  bool
+	}{
+		{"error", ErrInvalid, true},
+		{"wrapped error", Wrap(ErrInvalid, "note"), true},
+		{"PanicError", NewPanicError(0, ErrInvalid), true},
+		{"string", ErrInvalid.Error(), false},
+		{"non-error", 42, false},
+	} {
+		err := Catch(func() error {
+			panic(tc.payload)
+		})
+
+		if _, ok := err.(Recovered); !ok {
+			t.Errorf("Catch: %s: expected Recovered, got %#v", tc.name, err)
+		} else if r := errors.Is(err, ErrInvalid); r != tc.expect {
+			t.Errorf("Catch: %s: errors.Is → %v (expected %v)", tc.name, r, tc.expect)
+		}
+	}
+}
+
+func TestCatchValue(t *testing.T) {
+	v, err := CatchValue(func() (int, error) { return 42, nil })
+	if v != 42 || err != nil {
+		t.Errorf("CatchValue: %v, %v", v, err)
+	}
+
+	v, err = CatchValue(func() (int, error) { return 1, errTestOrganic })
+	if v != 1 || err != errTestOrganic {
+		t.Errorf("CatchValue: %v, %v", v, err)
+	}
+
+}
+
+func TestCatchValuePanic(t *testing.T) {
+	v, err := CatchValue(func() (int, error) { panic(errTestOrganic) })
+	if _, ok := err.(*PanicError); !ok || v != 0 || !errors.Is(err, errTestOrganic) {
+		t.Errorf("CatchValue: %v, %#v", v, err)
+	}
+
+	v, err = CatchValue[int](nil)
+	if v != 0 || err != nil {
+		t.Errorf("CatchValue(nil): %v, %v", v, err)
+	}
+}
+
+func TestCatchFunc(t *testing.T) {
+	var called bool
+	if err := CatchFunc(func() { called = true }); err != nil || !called {
+		t.Errorf("CatchFunc: %v (called:%v)", err, called)
+	}
+
+	err := CatchFunc(func() { panic(errTestOrganic) })
+	if _, ok := err.(*PanicError); !ok || !errors.Is(err, errTestOrganic) {
+		t.Errorf("CatchFunc: %#v", err)
+	}
+
+	if err := CatchFunc(nil); err != nil {
+		t.Errorf("CatchFunc(nil): %v", err)
+	}
+}
@@ -6,9 +6,10 @@ import (
 )
 
 var (
-	_ Recovered   = (*PanicError)(nil)
-	_ Unwrappable = (*PanicError)(nil)
-	_ CallStacker = (*PanicError)(nil)
+	_ fmt.Formatter = (*PanicError)(nil)
+	_ Recovered     = (*PanicError)(nil)
+	_ Unwrappable   = (*PanicError)(nil)
+	_ CallStacker   = (*PanicError)(nil)
 )
 
 // PanicError is an error to be sent via panic, ideally
@@ -23,7 +24,31 @@ func (p *PanicError) Error() string {
 	return fmt.Sprintf("panic: %s", p.payload)
 }
 
-// Unwrap returns the payload if it's and error
+// Format formats the error according to the fmt.Formatter interface.
+//
+//	%s    the error message
+//	%q    the error message, quoted
+//	%v    equivalent to %s
+//	%+v   the error message followed by the call stack
+//	      as described in Stack.Format
+//	%#+v  the error message followed by the numbered call stack
+func (p *PanicError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'q':
+		writeFormat(s, fmt.Sprintf("%q", p.Error()))
+	case 's':
+		writeFormat(s, p.Error())
+	case 'v':
+		writeFormat(s, p.Error())
+		if s.Flag('+') {
+			p.stack.Format(s, verb)
+		}
+	}
+}
+
+// Unwrap returns the payload if it's an error, which
+// also allows errors.Is to match sentinel errors used
+// directly as panic payload.
 func (p *PanicError) Unwrap() error {
 	if err, ok := p.payload.(error); ok {
 		return err
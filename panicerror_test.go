@@ -0,0 +1,109 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPanicErrorFormat(t *testing.T) {
+	err := NewPanicError(0, "oops")
+	msg := "panic: oops"
+
+	for _, tc := range []struct {
+		format string
+		expect string
+	}{
+		{"%s", msg},
+		{"%v", msg},
+		{"%q", `"panic: oops"`},
+	} {
+		if s := fmt.Sprintf(tc.format, err); s != tc.expect {
+			t.Errorf("PanicError: %q → %q (expected %q)", tc.format, s, tc.expect)
+		}
+	}
+
+	// with stack
+	s := fmt.Sprintf("%+v", err)
+	lines := strings.Split(s, "\n")
+	switch {
+	case len(lines) < 3:
+		t.Fatalf("PanicError: %q → %q", "%+v", s)
+	case lines[0] != msg:
+		t.Errorf("PanicError: %q → %q (expected %q)", "%+v", lines[0], msg)
+	case !strings.HasSuffix(lines[1], ".TestPanicErrorFormat"):
+		t.Errorf("PanicError: %q → %q", "%+v", lines[1])
+	}
+
+	// numbered stack
+	s = fmt.Sprintf("%#+v", err)
+	if !strings.HasPrefix(s, msg+"\n[0/") {
+		t.Errorf("PanicError: %q → %q", "%#+v", s)
+	}
+}
+
+type sliceError []string
+
+func (e sliceError) Error() string { return strings.Join(e, ", ") }
+
+func TestPanicErrorUnwrapIs(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		payload any
+		target  error
+		expect  bool
+	}{
+		{"raw sentinel", ErrInvalid, ErrInvalid, true},
+		{"wrapped sentinel", Wrap(ErrInvalid, "note"), ErrInvalid, true},
+		{"other sentinel", ErrInvalid, ErrUnknown, false},
+		{"nil target", ErrInvalid, nil, false},
+		{"non-error", 42, ErrInvalid, false},
+		{"non-comparable", sliceError{"a"}, sliceError{"a"}, false},
+	} {
+		err := NewPanicError(0, tc.payload)
+		if r := errors.Is(err, tc.target); r != tc.expect {
+			t.Errorf("errors.Is: %s → %v (expected %v)", tc.name, r, tc.expect)
+		}
+	}
+}
+
+func TestPanicErrorUnwrap(t *testing.T) {
+	wrapped := Wrap(ErrInvalid, "note")
+
+	for _, tc := range []struct {
+		name    string
+		payload any
+		expect  error
+	}{
+		{"sentinel", ErrInvalid, ErrInvalid},
+		{"wrapped", wrapped, wrapped},
+		{"non-error", 42, nil},
+	} {
+		p := NewPanicError(0, tc.payload)
+		if r := p.Unwrap(); r != tc.expect {
+			t.Errorf("PanicError.Unwrap: %s → %v (expected %v)", tc.name, r, tc.expect)
+		}
+		if tc.expect != nil && !errors.Is(p, tc.expect) {
+			t.Errorf("errors.Is: %s → false", tc.name)
+		}
+	}
+}
+
+func TestPanicErrorChain(t *testing.T) {
+	// sentinel deep in the payload's chain
+	p := NewPanicError(0, fmt.Errorf("outer: %w", Wrap(ErrInvalid, "inner")))
+	if !errors.Is(p, ErrInvalid) {
+		t.Errorf("errors.Is: %v doesn't match %v", p, ErrInvalid)
+	}
+	if errors.Is(p, ErrUnknown) {
+		t.Errorf("errors.Is: %v matches %v", p, ErrUnknown)
+	}
+
+	// inner type through the PanicError
+	var target sliceError
+	p = NewPanicError(0, fmt.Errorf("outer: %w", Wrap(sliceError{"a", "b"}, "inner")))
+	if !errors.As(p, &target) || !SliceEqual(target, S("a", "b")) {
+		t.Errorf("errors.As: %v → %v", p, target)
+	}
+}
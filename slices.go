@@ -2,8 +2,10 @@ package core
 
 import (
 	"crypto/rand"
+	"fmt"
 	"math/big"
 	"sort"
+	"strings"
 )
 
 // SliceMinus returns a new slice containing only the
@@ -29,6 +31,61 @@ func SliceMinusFn[T any](a, b []T, eq func(T, T) bool) []T {
 	return SliceCopyFn(a, fn)
 }
 
+// SliceRemove returns a new slice with every occurrence
+// of the given value removed, preserving the order of the rest.
+// As opposed to [SliceMinus], it removes a single value instead of
+// the elements of another slice.
+func SliceRemove[T comparable](a []T, v T) []T {
+	return SliceRemoveFn(a, func(va T) bool {
+		return va == v
+	})
+}
+
+// SliceRemoveFn returns a new slice without the elements
+// satisfying the given condition, preserving the order of the rest.
+// If no condition is given the result is a shallow copy.
+func SliceRemoveFn[T any](a []T, cond func(T) bool) []T {
+	if cond == nil {
+		return SliceCopy(a)
+	}
+
+	return SliceCopyFn(a, func(_ []T, v T) (T, bool) {
+		return v, !cond(v)
+	})
+}
+
+// SliceFilter returns a new slice with only the elements
+// satisfying the given condition, preserving their order.
+// A nil slice returns nil, and if no condition is given
+// the result is a shallow copy.
+func SliceFilter[T any](a []T, keep func(T) bool) []T {
+	switch {
+	case a == nil:
+		return nil
+	case keep == nil:
+		return SliceCopy(a)
+	default:
+		return SliceCopyFn(a, func(_ []T, v T) (T, bool) {
+			return v, keep(v)
+		})
+	}
+}
+
+// SliceFilterInPlace moves the elements satisfying the given
+// condition to the front of the slice, preserving their order,
+// and returns it truncated. The elements beyond the new length
+// are left untouched.
+// If no condition is given the slice is returned unchanged.
+func SliceFilterInPlace[T any](a []T, keep func(T) bool) []T {
+	if keep == nil {
+		return a
+	}
+
+	return SliceReplaceFn(a, func(_ []T, v T) (T, bool) {
+		return v, keep(v)
+	})
+}
+
 // SliceContains tells if a slice contains a given element
 func SliceContains[T comparable](a []T, v T) bool {
 	return SliceContainsFn(a, v, func(va, vb T) bool {
@@ -37,8 +94,13 @@ func SliceContains[T comparable](a []T, v T) bool {
 }
 
 // SliceContainsFn tells if a slice contains a given element
-// according to the callback eq
+// according to the callback eq. If eq is nil, nothing is contained.
+// For predicate-based membership see [SliceAny].
 func SliceContainsFn[T any](a []T, v T, eq func(T, T) bool) bool {
+	if eq == nil {
+		return false
+	}
+
 	for _, va := range a {
 		if eq(va, v) {
 			return true
@@ -47,6 +109,105 @@ func SliceContainsFn[T any](a []T, v T, eq func(T, T) bool) bool {
 	return false
 }
 
+// SliceAny tells if any element of a slice satisfies
+// the given condition. Nil slices and nil conditions
+// always return false.
+func SliceAny[T any](a []T, cond func(T) bool) bool {
+	if cond == nil {
+		return false
+	}
+
+	for _, v := range a {
+		if cond(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// SliceIndexOf returns the index of the first occurrence of
+// a value in a slice, or -1 if it isn't present.
+func SliceIndexOf[T comparable](a []T, v T) int {
+	return SliceIndexOfFn(a, func(va T) bool {
+		return va == v
+	})
+}
+
+// SliceIndexOfFn returns the index of the first element of a slice
+// satisfying the given condition, or -1 if none does.
+func SliceIndexOfFn[T any](a []T, cond func(T) bool) int {
+	if cond != nil {
+		for i, v := range a {
+			if cond(v) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// SliceIndexAll returns the indices of every occurrence of
+// a value in a slice, or an empty slice if there are none.
+func SliceIndexAll[T comparable](a []T, v T) []int {
+	return SliceIndexAllFn(a, func(va T) bool {
+		return va == v
+	})
+}
+
+// SliceIndexAllFn returns the indices of every element of a slice
+// satisfying the given condition, or an empty slice if there are none.
+func SliceIndexAllFn[T any](a []T, cond func(T) bool) []int {
+	out := []int{}
+	if cond != nil {
+		for i, v := range a {
+			if cond(v) {
+				out = append(out, i)
+			}
+		}
+	}
+	return out
+}
+
+// SliceContainsSubsequence tells if a slice contains another
+// as a contiguous subsequence. An empty subsequence is always
+// contained.
+func SliceContainsSubsequence[T comparable](a, sub []T) bool {
+	return SliceIndexOfSubsequence(a, sub) >= 0
+}
+
+// SliceContainsSubsequenceFn tells if a slice contains another
+// as a contiguous subsequence according to the callback eq
+func SliceContainsSubsequenceFn[T any](a, sub []T, eq func(T, T) bool) bool {
+	return SliceIndexOfSubsequenceFn(a, sub, eq) >= 0
+}
+
+// SliceIndexOfSubsequence returns the index where the first occurrence
+// of a contiguous subsequence starts within a slice, or -1 if not found.
+// An empty subsequence is found at index 0.
+func SliceIndexOfSubsequence[T comparable](a, sub []T) int {
+	return SliceIndexOfSubsequenceFn(a, sub, func(va, vb T) bool {
+		return va == vb
+	})
+}
+
+// SliceIndexOfSubsequenceFn returns the index where the first occurrence
+// of a contiguous subsequence starts within a slice according to the
+// callback eq, or -1 if not found or eq is nil.
+// An empty subsequence is found at index 0.
+func SliceIndexOfSubsequenceFn[T any](a, sub []T, eq func(T, T) bool) int {
+	if eq == nil {
+		return -1
+	}
+
+	l := len(sub)
+	for i := 0; i+l <= len(a); i++ {
+		if SliceEqualFn(a[i:i+l], sub, eq) {
+			return i
+		}
+	}
+	return -1
+}
+
 // SliceEqual tells if two slices are equal.
 func SliceEqual[T comparable](a, b []T) bool {
 	if len(a) != len(b) {
@@ -73,8 +234,69 @@ func SliceEqualFn[T any](a, b []T, eq func(va, vb T) bool) bool {
 	return true
 }
 
+// SliceEqualFold tells if two slices of strings are equal,
+// comparing elements case-insensitively using [strings.EqualFold].
+func SliceEqualFold(a, b []string) bool {
+	return SliceEqualFn(a, b, strings.EqualFold)
+}
+
+// SliceEqualFoldUnordered tells if two slices of strings contain the
+// same elements, with the same multiplicity, in any order, comparing
+// them case-insensitively using [strings.EqualFold].
+func SliceEqualFoldUnordered(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	used := make([]bool, len(b))
+	for _, va := range a {
+		j := indexUnusedEqualFold(b, used, va)
+		if j < 0 {
+			return false
+		}
+		used[j] = true
+	}
+	return true
+}
+
+// indexUnusedEqualFold returns the index of the first element of s
+// not yet used that is equal to v under Unicode case-folding,
+// or -1 if there is none.
+func indexUnusedEqualFold(s []string, used []bool, v string) int {
+	for i, vs := range s {
+		if !used[i] && strings.EqualFold(v, vs) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SliceCompare compares two slices of an [Ordered] type lexicographically,
+// returning -1 if a < b, 0 if a == b, and 1 if a > b.
+// If one slice is a prefix of the other, the shorter one is the lesser.
+func SliceCompare[T Ordered](a, b []T) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
 // SliceUnique returns a new slice containing only
-// unique elements
+// unique elements, preserving the order of first occurrence.
+// It uses a map of seen values, taking O(n) time.
 func SliceUnique[T comparable](a []T) []T {
 	keys := make(map[T]bool, len(a))
 
@@ -92,7 +314,11 @@ func SliceUnique[T comparable](a []T) []T {
 }
 
 // SliceUniqueFn returns a new slice containing only
-// unique elements according to the callback eq
+// unique elements according to the callback eq, preserving
+// the order of first occurrence. If eq is nil all elements
+// are considered unique.
+// Each element is compared against the partial result,
+// taking O(n²) time.
 func SliceUniqueFn[T any](a []T, eq func(T, T) bool) []T {
 	// keep only elements not present on the partial
 	// result already
@@ -109,8 +335,47 @@ func SliceUniqueFn[T any](a []T, eq func(T, T) bool) []T {
 	return SliceCopyFn(a, fn)
 }
 
+// SliceUniqueBy returns a new slice containing only the first
+// element for each distinct key, preserving their order.
+// Nil input returns nil, and if no key function is given
+// all elements are considered unique and the result is
+// a shallow copy.
+func SliceUniqueBy[T any, K comparable](a []T, key func(T) K) []T {
+	switch {
+	case a == nil:
+		return nil
+	case key == nil:
+		return SliceCopy(a)
+	}
+
+	seen := make(map[K]bool, len(a))
+	return SliceCopyFn(a, func(_ []T, entry T) (T, bool) {
+		k := key(entry)
+		if seen[k] {
+			return entry, false
+		}
+		seen[k] = true
+		return entry, true
+	})
+}
+
+// SliceGroupBy groups the elements of a slice by the key produced
+// by the given function, preserving their order within each group.
+// The returned map is never nil.
+func SliceGroupBy[T any, K comparable](a []T, key func(T) K) map[K][]T {
+	out := make(map[K][]T)
+	if key != nil {
+		for _, v := range a {
+			k := key(v)
+			out[k] = append(out[k], v)
+		}
+	}
+	return out
+}
+
 // SliceUniquify returns the same slice, reduced to
-// only contain unique elements
+// only contain unique elements, preserving the order
+// of first occurrence
 func SliceUniquify[T comparable](ptr *[]T) []T {
 	if ptr == nil {
 		return []T{}
@@ -133,7 +398,8 @@ func SliceUniquify[T comparable](ptr *[]T) []T {
 }
 
 // SliceUniquifyFn returns the same slice, reduced to
-// only contain unique elements according to the callback eq
+// only contain unique elements according to the callback eq,
+// preserving the order of first occurrence
 func SliceUniquifyFn[T any](ptr *[]T, eq func(T, T) bool) []T {
 	if ptr == nil {
 		return []T{}
@@ -177,6 +443,11 @@ func SliceReplaceFn[T any](s []T,
 // SliceCopyFn makes a copy of a slice, optionally modifying in-flight
 // the items using a function. If no function is provided,
 // the destination will be a shallow copy of the source slice.
+//
+// The function receives the partial result and the original value,
+// and returns the value to be included, which may be transformed,
+// or false to drop it.
+// The returned slice is never nil, even when the source is.
 func SliceCopyFn[T any](s []T,
 	fn func(partial []T, before T) (after T, include bool),
 ) []T {
@@ -205,6 +476,57 @@ func SliceCopy[T any](s []T) []T {
 	return result
 }
 
+// SliceClear sets every element of a slice to its zero value,
+// without changing its length. Useful to scrub sensitive data
+// and to help the garbage collector.
+func SliceClear[T any](s []T) {
+	var zero T
+	for i := range s {
+		s[i] = zero
+	}
+}
+
+// SliceClearFn sets to their zero value only the elements of a slice
+// matching the given condition, without changing its length.
+func SliceClearFn[T any](s []T, cond func(T) bool) {
+	var zero T
+
+	if cond == nil {
+		return
+	}
+
+	for i, v := range s {
+		if cond(v) {
+			s[i] = zero
+		}
+	}
+}
+
+// SliceForEach calls a function for each element of a slice,
+// with its index, until told to stop
+func SliceForEach[T any](s []T, fn func(i int, v T) bool) {
+	if fn == nil {
+		return
+	}
+
+	for i, v := range s {
+		if fn(i, v) {
+			break
+		}
+	}
+}
+
+// SliceCopyRange makes a shallow copy of s[low:high] that doesn't
+// share memory with the source slice.
+// SliceCopyRange panics if the range is invalid.
+func SliceCopyRange[T any](s []T, low, high int) []T {
+	if low < 0 || high < low || high > len(s) {
+		PanicWrapf(ErrInvalid, "SliceCopyRange: range [%v:%v] out of bounds [0:%v]",
+			low, high, len(s))
+	}
+	return SliceCopy(s[low:high])
+}
+
 // SliceMap takes a []T1 and uses a function to produce a []T2
 // by processing each item on the source slice.
 func SliceMap[T1 any, T2 any](a []T1,
@@ -219,6 +541,170 @@ func SliceMap[T1 any, T2 any](a []T1,
 	return result
 }
 
+// SliceTransform takes a []T1 and uses a function to produce a []T2
+// with exactly one entry for each item on the source slice.
+// Use [SliceMap] when entries may need to be skipped, expanded,
+// or depend on the partial result.
+func SliceTransform[T1 any, T2 any](a []T1, fn func(v T1) T2) []T2 {
+	if a == nil || fn == nil {
+		return nil
+	}
+
+	result := make([]T2, len(a))
+	for i, v := range a {
+		result[i] = fn(v)
+	}
+	return result
+}
+
+// SliceFirst returns the first element of a slice
+// or the zero value and false if it's empty
+func SliceFirst[T any](a []T) (T, bool) {
+	if len(a) > 0 {
+		return a[0], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// SliceLast returns the last element of a slice
+// or the zero value and false if it's empty
+func SliceLast[T any](a []T) (T, bool) {
+	if l := len(a); l > 0 {
+		return a[l-1], true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// SliceMinMax returns the lowest and highest elements of a slice
+// of an [Ordered] type in a single pass, or false if it's empty.
+// NaN values compare false to everything, so unless the first
+// element is NaN they are never selected.
+func SliceMinMax[T Ordered](a []T) (lo, hi T, ok bool) {
+	if len(a) == 0 {
+		return lo, hi, false
+	}
+
+	lo, hi = a[0], a[0]
+	for _, v := range a[1:] {
+		switch {
+		case v < lo:
+			lo = v
+		case v > hi:
+			hi = v
+		}
+	}
+	return lo, hi, true
+}
+
+// SliceMin returns the lowest element of a slice of an [Ordered] type,
+// or false if it's empty. See [SliceMinMax] for NaN handling.
+func SliceMin[T Ordered](a []T) (T, bool) {
+	lo, _, ok := SliceMinMax(a)
+	return lo, ok
+}
+
+// SliceMax returns the highest element of a slice of an [Ordered] type,
+// or false if it's empty. See [SliceMinMax] for NaN handling.
+func SliceMax[T Ordered](a []T) (T, bool) {
+	_, hi, ok := SliceMinMax(a)
+	return hi, ok
+}
+
+// SliceSum returns the sum of all elements of a slice of a [Number]
+// or [Complex] type, or zero if it's empty.
+func SliceSum[T Number | Complex](a []T) T {
+	var sum T
+	for _, v := range a {
+		sum += v
+	}
+	return sum
+}
+
+// SliceSumFn returns the sum of the values derived from each element
+// of a slice using the given function, or zero if it's empty or the
+// function is nil.
+func SliceSumFn[T any, N Number | Complex](a []T, fn func(T) N) N {
+	var sum N
+	if fn != nil {
+		for _, v := range a {
+			sum += fn(v)
+		}
+	}
+	return sum
+}
+
+// SliceStrings returns the string representation of each
+// element of a slice, using [fmt.Sprint].
+func SliceStrings[T any](a []T) []string {
+	return doSliceStrings(a, nil)
+}
+
+// SliceJoinString renders each element of a slice using the given
+// function, or [fmt.Sprint] if nil, and joins them using sep.
+// An empty slice produces an empty string.
+func SliceJoinString[T any](a []T, sep string, fn func(T) string) string {
+	return strings.Join(doSliceStrings(a, fn), sep)
+}
+
+func doSliceStrings[T any](a []T, fn func(T) string) []string {
+	if fn == nil {
+		fn = func(v T) string {
+			return fmt.Sprint(v)
+		}
+	}
+
+	out := make([]string, 0, len(a))
+	for _, v := range a {
+		out = append(out, fn(v))
+	}
+	return out
+}
+
+// SliceFlatMap takes a []T1 and uses a function to produce zero
+// or more T2 entries from each item of the source slice,
+// concatenating them. As opposed to [SliceMap] the function
+// doesn't receive the partial result.
+// The returned slice is never nil.
+func SliceFlatMap[T1 any, T2 any](a []T1, fn func(v T1) []T2) []T2 {
+	result := []T2{}
+	if fn != nil {
+		for _, v := range a {
+			result = append(result, fn(v)...)
+		}
+	}
+	return result
+}
+
+// SliceReduce folds a slice from left to right into an accumulator,
+// calling the function once per element, in order, starting with
+// the given initial value.
+// If no function is given the initial value is returned.
+func SliceReduce[T any, A any](a []T, initial A, fn func(acc A, v T) A) A {
+	acc := initial
+	if fn != nil {
+		for _, v := range a {
+			acc = fn(acc, v)
+		}
+	}
+	return acc
+}
+
+// SliceReduceRight folds a slice like [SliceReduce] but
+// from right to left.
+func SliceReduceRight[T any, A any](a []T, initial A, fn func(acc A, v T) A) A {
+	acc := initial
+	if fn != nil {
+		for i := len(a) - 1; i >= 0; i-- {
+			acc = fn(acc, a[i])
+		}
+	}
+	return acc
+}
+
 // SliceRandom returns a random element from a slice
 // if the slice is empty it will return the zero value
 // of the slice type and false
@@ -267,6 +753,35 @@ func SliceSortOrdered[T Ordered](x []T) {
 	}
 }
 
+// SliceInsertSorted inserts a value into a slice of an [Ordered] type
+// keeping it sorted in ascending order, and returns the resulting slice.
+// The slice is assumed to be already sorted.
+func SliceInsertSorted[T Ordered](x []T, v T) []T {
+	return SliceInsertSortedFn(x, v, func(a, b T) bool {
+		return a < b
+	})
+}
+
+// SliceInsertSortedFn inserts a value into a slice keeping it sorted
+// according to a less function, and returns the resulting slice.
+// The slice is assumed to be already sorted, and the value is placed
+// after any equal element. If less is nil the value is appended.
+func SliceInsertSortedFn[T any](x []T, v T, less func(a, b T) bool) []T {
+	if less == nil {
+		return append(x, v)
+	}
+
+	i := sort.Search(len(x), func(i int) bool {
+		return less(v, x[i])
+	})
+
+	var zero T
+	x = append(x, zero)
+	copy(x[i+1:], x[i:])
+	x[i] = v
+	return x
+}
+
 func doSliceSort[T any](x []T, less func(a, b T) bool) {
 	s := sortable[T]{
 		x:    x,
@@ -310,6 +825,44 @@ func SliceReverse[T any](x []T) {
 	}
 }
 
+// ReverseString returns a copy of a string with its runes in
+// reverse order, so multi-byte UTF-8 sequences aren't corrupted.
+// Grapheme clusters, like characters followed by combining marks,
+// are not kept together.
+func ReverseString(s string) string {
+	r := []rune(s)
+	SliceReverse(r)
+	return string(r)
+}
+
+// ReverseBytes returns a copy of a byte slice in reverse order.
+// Reversing UTF-8 text this way corrupts multi-byte sequences,
+// use [ReverseString] instead.
+func ReverseBytes(b []byte) []byte {
+	return SliceReversed(b)
+}
+
+// SliceMove moves the element at index from to index to, shifting
+// the elements in between. The slice is modified in place.
+// SliceMove panics if either index is out of range.
+func SliceMove[T any](s []T, from, to int) {
+	l := len(s)
+	switch {
+	case from < 0 || from >= l:
+		PanicWrapf(ErrInvalid, "SliceMove: from index %v out of range [0, %v)", from, l)
+	case to < 0 || to >= l:
+		PanicWrapf(ErrInvalid, "SliceMove: to index %v out of range [0, %v)", to, l)
+	case from < to:
+		v := s[from]
+		copy(s[from:to], s[from+1:to+1])
+		s[to] = v
+	case from > to:
+		v := s[from]
+		copy(s[to+1:from+1], s[to:from])
+		s[to] = v
+	}
+}
+
 // SliceReversed returns a copy of the slice, in reverse order.
 func SliceReversed[T any](a []T) []T {
 	b := SliceCopy(a)
@@ -324,3 +877,30 @@ func SliceReversedFn[T any](a []T,
 	SliceReverse(b)
 	return b
 }
+
+// The following helpers follow the naming and semantics of the
+// standard slices package, forwarding to their equivalents here.
+
+// SliceEqualFunc is equivalent to [SliceEqualFn], named after
+// [slices.EqualFunc].
+func SliceEqualFunc[T any](a, b []T, eq func(va, vb T) bool) bool {
+	return SliceEqualFn(a, b, eq)
+}
+
+// SliceContainsFunc is equivalent to [SliceAny], named after
+// [slices.ContainsFunc].
+func SliceContainsFunc[T any](a []T, cond func(T) bool) bool {
+	return SliceAny(a, cond)
+}
+
+// SliceIndexFunc is equivalent to [SliceIndexOfFn], named after
+// [slices.IndexFunc].
+func SliceIndexFunc[T any](a []T, cond func(T) bool) int {
+	return SliceIndexOfFn(a, cond)
+}
+
+// SliceSortFunc is equivalent to [SliceSort], named after
+// [slices.SortFunc].
+func SliceSortFunc[T any](x []T, cmp func(a, b T) int) {
+	SliceSort(x, cmp)
+}
@@ -1,7 +1,11 @@
 package core
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -27,6 +31,262 @@ func TestSliceReverse(t *testing.T) {
 	}
 }
 
+func TestReverseString(t *testing.T) {
+	for _, tc := range []struct{ a, b string }{
+		{"", ""},
+		{"a", "a"},
+		{"hello", "olleh"},
+		{"ñandú", "údnañ"},
+		{"\u4E16\u754C", "\u754C\u4E16"},
+		{"a\U0001F600b", "b\U0001F600a"},
+		// combining marks end up before their base character
+		{"e\u0301x", "x\u0301e"},
+	} {
+		if s := ReverseString(tc.a); s != tc.b {
+			t.Errorf("ERROR: %s(%q) → %q (expected %q)", "ReverseString", tc.a, s, tc.b)
+		}
+	}
+
+	b := []byte("abc")
+	if s := ReverseBytes(b); string(s) != "cba" || string(b) != "abc" {
+		t.Errorf("ERROR: %s(%q) → %q", "ReverseBytes", b, s)
+	}
+}
+
+func TestSliceMove(t *testing.T) {
+	for _, tc := range []struct {
+		a        []int
+		from, to int
+		b        []int
+	}{
+		{S(1), 0, 0, S(1)},
+		{S(1, 2, 3), 1, 1, S(1, 2, 3)},
+		{S(1, 2, 3, 4, 5), 0, 4, S(2, 3, 4, 5, 1)},
+		{S(1, 2, 3, 4, 5), 4, 0, S(5, 1, 2, 3, 4)},
+		{S(1, 2, 3, 4, 5), 1, 3, S(1, 3, 4, 2, 5)},
+		{S(1, 2, 3, 4, 5), 3, 1, S(1, 4, 2, 3, 5)},
+	} {
+		c := SliceCopy(tc.a)
+		SliceMove(c, tc.from, tc.to)
+		if !SliceEqual(c, tc.b) {
+			t.Errorf("ERROR: %s(%v, %v, %v) → %v (expected %v)",
+				"SliceMove", tc.a, tc.from, tc.to, c, tc.b)
+		}
+	}
+}
+
+func TestSliceMoveOutOfRange(t *testing.T) {
+	for _, tc := range []struct {
+		a        []int
+		from, to int
+	}{
+		{S[int](), 0, 0},
+		{S(1, 2, 3), -1, 0},
+		{S(1, 2, 3), 3, 0},
+		{S(1, 2, 3), 0, -1},
+		{S(1, 2, 3), 0, 3},
+	} {
+		err := Catch(func() error {
+			SliceMove(tc.a, tc.from, tc.to)
+			return nil
+		})
+		if !errors.Is(err, ErrInvalid) {
+			t.Errorf("ERROR: %s(%v, %v, %v) → %v (expected %v)",
+				"SliceMove", tc.a, tc.from, tc.to, err, ErrInvalid)
+		}
+	}
+}
+
+func TestSliceEqualFold(t *testing.T) {
+	for _, tc := range []struct {
+		a, b      []string
+		ordered   bool
+		unordered bool
+	}{
+		{nil, nil, true, true},
+		{nil, S[string](), true, true},
+		{S("a"), nil, false, false},
+		{S("Content-Type", "ACCEPT"), S("content-type", "accept"), true, true},
+		{S("Content-Type", "ACCEPT"), S("accept", "content-type"), false, true},
+		{S("a", "a", "b"), S("A", "b", "b"), false, false},
+		{S("a", "b"), S("a", "c"), false, false},
+	} {
+		if r := SliceEqualFold(tc.a, tc.b); r != tc.ordered {
+			t.Errorf("ERROR: %s(%q, %q) → %v", "SliceEqualFold", tc.a, tc.b, r)
+		}
+		if r := SliceEqualFoldUnordered(tc.a, tc.b); r != tc.unordered {
+			t.Errorf("ERROR: %s(%q, %q) → %v", "SliceEqualFoldUnordered", tc.a, tc.b, r)
+		}
+	}
+}
+
+func TestSliceCompare(t *testing.T) {
+	for _, tc := range []struct {
+		a, b   []int
+		expect int
+	}{
+		{nil, nil, 0},
+		{nil, S[int](), 0},
+		{S(1), nil, 1},
+		{nil, S(1), -1},
+		{S(1, 2, 3), S(1, 2, 3), 0},
+		{S(1, 2), S(1, 2, 3), -1},
+		{S(1, 2, 3), S(1, 2), 1},
+		{S(1, 3), S(1, 2, 3), 1},
+		{S(1, 2, 3), S(1, 3), -1},
+	} {
+		if r := SliceCompare(tc.a, tc.b); r != tc.expect {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)",
+				"SliceCompare", tc.a, tc.b, r, tc.expect)
+		}
+	}
+}
+
+func TestSliceClear(t *testing.T) {
+	s := S(1, 2, 3, 4)
+	SliceClear(s)
+	if !SliceEqual(s, S(0, 0, 0, 0)) {
+		t.Errorf("ERROR: %s → %v", "SliceClear", s)
+	}
+
+	s = S(1, 2, 3, 4)
+	SliceClearFn(s, func(v int) bool { return v%2 == 0 })
+	if !SliceEqual(s, S(1, 0, 3, 0)) {
+		t.Errorf("ERROR: %s → %v", "SliceClearFn", s)
+	}
+
+	s = S(1, 2, 3, 4)
+	SliceClearFn(s, nil)
+	if !SliceEqual(s, S(1, 2, 3, 4)) {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceClearFn", s)
+	}
+
+	// nil slices
+	SliceClear[int](nil)
+	SliceClearFn[int](nil, func(int) bool { return true })
+}
+
+func TestSliceAny(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	for _, tc := range []struct {
+		a      []int
+		cond   func(int) bool
+		expect bool
+	}{
+		{nil, even, false},
+		{S(1, 3, 5), even, false},
+		{S(1, 3, 4), even, true},
+		{S(2), even, true},
+		{S(2), nil, false},
+	} {
+		if r := SliceAny(tc.a, tc.cond); r != tc.expect {
+			t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceAny", tc.a, r, tc.expect)
+		}
+	}
+}
+
+func TestSliceIndexOf(t *testing.T) {
+	for _, tc := range []struct {
+		a      []int
+		v      int
+		expect int
+	}{
+		{nil, 1, -1},
+		{[]int{}, 1, -1},
+		{S(1, 2, 3), 4, -1},
+		{S(1, 2, 3), 1, 0},
+		{S(1, 2, 3), 2, 1},
+		{S(1, 2, 3), 3, 2},
+		{S(1, 2, 3, 2), 2, 1},
+	} {
+		if r := SliceIndexOf(tc.a, tc.v); r != tc.expect {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)", "SliceIndexOf", tc.a, tc.v, r, tc.expect)
+		}
+	}
+
+	even := func(v int) bool { return v%2 == 0 }
+	if r := SliceIndexOfFn(S(1, 4, 6), even); r != 1 {
+		t.Errorf("ERROR: %s → %v", "SliceIndexOfFn", r)
+	}
+	if r := SliceIndexOfFn(S(1, 2), nil); r != -1 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceIndexOfFn", r)
+	}
+}
+
+func TestSliceIndexAll(t *testing.T) {
+	for _, tc := range []struct {
+		a      []int
+		v      int
+		expect []int
+	}{
+		{nil, 1, S[int]()},
+		{S(2, 3), 1, S[int]()},
+		{S(1), 1, S(0)},
+		{S(1, 2, 1, 3, 1), 1, S(0, 2, 4)},
+		{S(2, 1, 1), 1, S(1, 2)},
+	} {
+		s := SliceIndexAll(tc.a, tc.v)
+		if s == nil || !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)", "SliceIndexAll", tc.a, tc.v, s, tc.expect)
+		}
+	}
+
+	if s := SliceIndexAllFn(S(1, 2), nil); s == nil || len(s) != 0 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceIndexAllFn", s)
+	}
+}
+
+func TestSliceIndexOfSubsequence(t *testing.T) {
+	for _, tc := range []struct {
+		a, sub []int
+		expect int
+	}{
+		{nil, nil, 0},
+		{S(1, 2, 3), nil, 0},
+		{nil, S(1), -1},
+		{S(1, 2, 3), S(1, 2, 3), 0},
+		{S(1, 2, 3), S(1, 2, 3, 4), -1},
+		{S(1, 2, 3, 4), S(2, 3), 1},
+		{S(1, 2, 3, 4), S(3, 4), 2},
+		{S(1, 2, 3, 4), S(2, 4), -1},
+		{S(1, 2, 1, 2, 3), S(1, 2, 3), 2},
+	} {
+		if r := SliceIndexOfSubsequence(tc.a, tc.sub); r != tc.expect {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)",
+				"SliceIndexOfSubsequence", tc.a, tc.sub, r, tc.expect)
+		}
+		if r := SliceContainsSubsequence(tc.a, tc.sub); r != (tc.expect >= 0) {
+			t.Errorf("ERROR: %s(%v, %v) → %v",
+				"SliceContainsSubsequence", tc.a, tc.sub, r)
+		}
+	}
+
+	if r := SliceIndexOfSubsequenceFn(S(1, 2), S(1), nil); r != -1 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceIndexOfSubsequenceFn", r)
+	}
+}
+
+func TestSliceForEach(t *testing.T) {
+	var visited []int
+
+	SliceForEach(S(10, 20, 30, 40), func(i, v int) bool {
+		if v != (i+1)*10 {
+			t.Errorf("ERROR: %s: [%v] → %v", "SliceForEach", i, v)
+		}
+		visited = append(visited, i)
+		return v == 30
+	})
+
+	if !SliceEqual(visited, S(0, 1, 2)) {
+		t.Errorf("ERROR: %s: visited %v", "SliceForEach", visited)
+	}
+
+	// nil safety
+	SliceForEach[int](nil, func(int, int) bool { return false })
+	SliceForEach(S(1), nil)
+}
+
 // revive:disable
 var (
 	ints       = []int{74, 59, 238, -784, 9845, 959, 905, 0, 0, 42, 7586, -5467984, 7586}
@@ -105,6 +365,471 @@ func TestSliceUniqueString(t *testing.T) {
 	testSliceUnique(t, strs, expectStrs)
 }
 
+func TestSliceUniqueFnStable(t *testing.T) {
+	fold := func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		before []string
+		eq     func(string, string) bool
+		after  []string
+	}{
+		{"nil", nil, fold, S[string]()},
+		{"empty", S[string](), fold, S[string]()},
+		{"unique", S("b", "a", "c"), fold, S("b", "a", "c")},
+		{"first occurrence", S("b", "a", "b", "c", "a"), fold, S("b", "a", "c")},
+		{"case-insensitive", S("Foo", "bar", "FOO", "foo", "BAR", "baz"), fold,
+			S("Foo", "bar", "baz")},
+		{"nil eq", S("a", "a", "b"), nil, S("a", "a", "b")},
+	} {
+		s := SliceUniqueFn(tc.before, tc.eq)
+		if !SliceEqual(s, tc.after) {
+			t.Errorf("ERROR: %s: %s(%q) → %q (expected %q)",
+				tc.name, "SliceUniqueFn", tc.before, s, tc.after)
+		}
+	}
+}
+
+func TestSliceUniqueStable(t *testing.T) {
+	before := S(3, 1, 3, 2, 1, 4, 2)
+	after := S(3, 1, 2, 4)
+
+	if s := SliceUnique(before); !SliceEqual(s, after) {
+		t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceUnique", before, s, after)
+	}
+
+	s := SliceCopy(before)
+	if s2 := SliceUniquify(&s); !SliceEqual(s2, after) || !SliceEqual(s, after) {
+		t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceUniquify", before, s2, after)
+	}
+
+	s = SliceCopy(before)
+	if s2 := SliceUniquifyFn(&s, eq[int]); !SliceEqual(s2, after) || !SliceEqual(s, after) {
+		t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceUniquifyFn", before, s2, after)
+	}
+}
+
+func TestSliceCopyFn(t *testing.T) {
+	even := func(_ []int, v int) (int, bool) {
+		return v, v%2 == 0
+	}
+	double := func(_ []int, v int) (int, bool) {
+		return v * 2, true
+	}
+	evenDoubled := func(_ []int, v int) (int, bool) {
+		return v * 2, v%2 == 0
+	}
+
+	for _, tc := range []struct {
+		name   string
+		before []int
+		fn     func([]int, int) (int, bool)
+		after  []int
+	}{
+		{"nil", nil, nil, S[int]()},
+		{"nil filter", nil, even, S[int]()},
+		{"empty", S[int](), double, S[int]()},
+		{"copy", S(1, 2, 3, 4), nil, S(1, 2, 3, 4)},
+		{"filter", S(1, 2, 3, 4), even, S(2, 4)},
+		{"transform", S(1, 2, 3, 4), double, S(2, 4, 6, 8)},
+		{"filter and transform", S(1, 2, 3, 4), evenDoubled, S(4, 8)},
+	} {
+		s := SliceCopyFn(tc.before, tc.fn)
+		switch {
+		case s == nil:
+			t.Errorf("ERROR: %s: %s(%v) → nil", tc.name, "SliceCopyFn", tc.before)
+		case !SliceEqual(s, tc.after):
+			t.Errorf("ERROR: %s: %s(%v) → %v (expected %v)",
+				tc.name, "SliceCopyFn", tc.before, s, tc.after)
+		case len(s) > 0 && &s[0] == &tc.before[0]:
+			t.Errorf("ERROR: %s: %s(%v) shares memory", tc.name, "SliceCopyFn", tc.before)
+		}
+	}
+}
+
+func TestSliceFirstLast(t *testing.T) {
+	for _, tc := range []struct {
+		a           []int
+		first, last int
+		ok          bool
+	}{
+		{nil, 0, 0, false},
+		{S[int](), 0, 0, false},
+		{S(1), 1, 1, true},
+		{S(1, 2, 3), 1, 3, true},
+	} {
+		if v, ok := SliceFirst(tc.a); v != tc.first || ok != tc.ok {
+			t.Errorf("ERROR: %s(%v) → %v, %v", "SliceFirst", tc.a, v, ok)
+		}
+		if v, ok := SliceLast(tc.a); v != tc.last || ok != tc.ok {
+			t.Errorf("ERROR: %s(%v) → %v, %v", "SliceLast", tc.a, v, ok)
+		}
+	}
+}
+
+func TestSliceMinMax(t *testing.T) {
+	for _, tc := range []struct {
+		a      []int
+		lo, hi int
+		ok     bool
+	}{
+		{nil, 0, 0, false},
+		{S(1), 1, 1, true},
+		{S(3, 1, 2), 1, 3, true},
+		{ints, -5467984, 9845, true},
+	} {
+		lo, hi, ok := SliceMinMax(tc.a)
+		if lo != tc.lo || hi != tc.hi || ok != tc.ok {
+			t.Errorf("ERROR: %s(%v) → %v, %v, %v", "SliceMinMax", tc.a, lo, hi, ok)
+		}
+		if v, ok := SliceMin(tc.a); v != tc.lo || ok != tc.ok {
+			t.Errorf("ERROR: %s(%v) → %v, %v", "SliceMin", tc.a, v, ok)
+		}
+		if v, ok := SliceMax(tc.a); v != tc.hi || ok != tc.ok {
+			t.Errorf("ERROR: %s(%v) → %v, %v", "SliceMax", tc.a, v, ok)
+		}
+	}
+
+	// NaN
+	lo, hi, _ := SliceMinMax([]float64{1, math.NaN(), -1})
+	if lo != -1 || hi != 1 {
+		t.Errorf("ERROR: %s(NaN) → %v, %v", "SliceMinMax", lo, hi)
+	}
+
+	// strings
+	if v, _ := SliceMax(strs); v != "foo" {
+		t.Errorf("ERROR: %s(%q) → %q", "SliceMax", strs, v)
+	}
+}
+
+func TestSliceSum(t *testing.T) {
+	if v := SliceSum[int](nil); v != 0 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceSum", v)
+	}
+	if v := SliceSum(S(1, 2, 3, 4)); v != 10 {
+		t.Errorf("ERROR: %s → %v", "SliceSum", v)
+	}
+	if v := SliceSum([]float64{0.5, 0.25}); v != 0.75 {
+		t.Errorf("ERROR: %s → %v", "SliceSum", v)
+	}
+	if v := SliceSum([]complex128{1 + 2i, 3 - 1i}); v != 4+1i {
+		t.Errorf("ERROR: %s → %v", "SliceSum", v)
+	}
+
+	if v := SliceSumFn(strs, func(s string) int { return len(s) }); v != 25 {
+		t.Errorf("ERROR: %s → %v", "SliceSumFn", v)
+	}
+	if v := SliceSumFn[string, int](strs, nil); v != 0 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceSumFn", v)
+	}
+}
+
+func TestSliceIndexFunc(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+
+	for _, tc := range []struct {
+		a      []int
+		cond   func(int) bool
+		expect int
+	}{
+		{nil, even, -1},
+		{S(1, 3, 5), even, -1},
+		{S(2, 3, 4), even, 0},
+		{S(1, 3, 4, 6), even, 2},
+		{S(2), nil, -1},
+	} {
+		if r := SliceIndexFunc(tc.a, tc.cond); r != tc.expect {
+			t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceIndexFunc", tc.a, r, tc.expect)
+		}
+	}
+}
+
+func TestSliceJoinString(t *testing.T) {
+	hex := func(v int) string { return fmt.Sprintf("%#x", v) }
+
+	for _, tc := range []struct {
+		a      []int
+		sep    string
+		fn     func(int) string
+		expect string
+	}{
+		{nil, ", ", nil, ""},
+		{S(1), ", ", nil, "1"},
+		{S(1, 2, 3), ", ", nil, "1, 2, 3"},
+		{S(10, 255), " ", hex, "0xa 0xff"},
+	} {
+		if s := SliceJoinString(tc.a, tc.sep, tc.fn); s != tc.expect {
+			t.Errorf("ERROR: %s(%v) → %q (expected %q)", "SliceJoinString", tc.a, s, tc.expect)
+		}
+	}
+
+	if s := SliceStrings(S(1, 2)); !SliceEqual(s, S("1", "2")) {
+		t.Errorf("ERROR: %s → %q", "SliceStrings", s)
+	}
+}
+
+func TestSliceUniqueBy(t *testing.T) {
+	type record struct {
+		id   int
+		name string
+	}
+
+	records := []record{{1, "a"}, {2, "b"}, {1, "c"}, {3, "d"}, {2, "e"}}
+	byID := func(r record) int { return r.id }
+
+	s := SliceUniqueBy(records, byID)
+	expected := []record{{1, "a"}, {2, "b"}, {3, "d"}}
+	if !SliceEqual(s, expected) {
+		t.Errorf("ERROR: %s → %v (expected %v)", "SliceUniqueBy", s, expected)
+	}
+
+	if s := SliceUniqueBy(nil, byID); s != nil {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceUniqueBy", s)
+	}
+	if s := SliceUniqueBy[record, int](records, nil); !SliceEqual(s, records) {
+		t.Errorf("ERROR: %s(nil key) → %v", "SliceUniqueBy", s)
+	} else if &s[0] == &records[0] {
+		t.Errorf("ERROR: %s(nil key) didn't copy", "SliceUniqueBy")
+	}
+}
+
+func TestSliceGroupBy(t *testing.T) {
+	parity := func(v int) bool { return v%2 == 0 }
+
+	m := SliceGroupBy(S(1, 2, 3, 4, 5, 6, 7), parity)
+	if len(m) != 2 || !SliceEqual(m[true], S(2, 4, 6)) || !SliceEqual(m[false], S(1, 3, 5, 7)) {
+		t.Errorf("ERROR: %s → %v", "SliceGroupBy", m)
+	}
+
+	if m := SliceGroupBy(nil, parity); m == nil || len(m) != 0 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceGroupBy", m)
+	}
+	if m := SliceGroupBy[int, bool](S(1, 2), nil); m == nil || len(m) != 0 {
+		t.Errorf("ERROR: %s(nil key) → %v", "SliceGroupBy", m)
+	}
+}
+
+func TestSliceRemove(t *testing.T) {
+	for _, tc := range []struct {
+		a      []int
+		v      int
+		expect []int
+	}{
+		{nil, 1, S[int]()},
+		{S(1, 1), 1, S[int]()},
+		{S(1, 2, 3), 4, S(1, 2, 3)},
+		{S(1, 2, 1, 3, 1), 1, S(2, 3)},
+	} {
+		before := SliceCopy(tc.a)
+		if s := SliceRemove(tc.a, tc.v); !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)", "SliceRemove", tc.a, tc.v, s, tc.expect)
+		}
+		if !SliceEqual(before, tc.a) {
+			t.Errorf("ERROR: %s(%v, %v) modified its input", "SliceRemove", before, tc.v)
+		}
+	}
+
+	odd := func(v int) bool { return v%2 != 0 }
+	if s := SliceRemoveFn(S(1, 2, 3, 4), odd); !SliceEqual(s, S(2, 4)) {
+		t.Errorf("ERROR: %s → %v", "SliceRemoveFn", s)
+	}
+	if s := SliceRemoveFn(S(1, 2), nil); !SliceEqual(s, S(1, 2)) {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceRemoveFn", s)
+	}
+}
+
+func TestSliceFilter(t *testing.T) {
+	odd := func(v int) bool { return v%2 != 0 }
+
+	for _, tc := range []struct {
+		a      []int
+		expect []int
+	}{
+		{nil, nil},
+		{[]int{}, []int{}},
+		{S(2, 4), []int{}},
+		{S(1, 2, 3, 4, 5), S(1, 3, 5)},
+	} {
+		before := SliceCopy(tc.a)
+		s := SliceFilter(tc.a, odd)
+		if (s == nil) != (tc.expect == nil) || !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceFilter", tc.a, s, tc.expect)
+		}
+		if !SliceEqual(before, tc.a) {
+			t.Errorf("ERROR: %s(%v) modified its input", "SliceFilter", before)
+		}
+
+		s = SliceFilterInPlace(tc.a, odd)
+		if !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceFilterInPlace", before, s, tc.expect)
+		}
+		if len(s) > 0 && &s[0] != &tc.a[0] {
+			t.Errorf("ERROR: %s(%v) didn't reuse its input", "SliceFilterInPlace", before)
+		}
+	}
+
+	if s := SliceFilter(S(1, 2), nil); !SliceEqual(s, S(1, 2)) {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceFilter", s)
+	}
+	if s := SliceFilterInPlace(S(1, 2), nil); !SliceEqual(s, S(1, 2)) {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceFilterInPlace", s)
+	}
+}
+
+func TestSliceInsertSorted(t *testing.T) {
+	for _, tc := range []struct {
+		a      []int
+		v      int
+		expect []int
+	}{
+		{nil, 1, S(1)},
+		{S(2, 3), 1, S(1, 2, 3)},
+		{S(1, 3), 2, S(1, 2, 3)},
+		{S(1, 2), 3, S(1, 2, 3)},
+		{S(1, 2, 2, 3), 2, S(1, 2, 2, 2, 3)},
+	} {
+		a := SliceCopy(tc.a)
+		if s := SliceInsertSorted(a, tc.v); !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)",
+				"SliceInsertSorted", tc.a, tc.v, s, tc.expect)
+		}
+	}
+
+	// stability, equal elements keep insertion order
+	type entry struct{ k, v int }
+	less := func(a, b entry) bool { return a.k < b.k }
+
+	var s []entry
+	for i, k := range S(2, 1, 2, 1) {
+		s = SliceInsertSortedFn(s, entry{k, i}, less)
+	}
+	expected := []entry{{1, 1}, {1, 3}, {2, 0}, {2, 2}}
+	if !SliceEqual(s, expected) {
+		t.Errorf("ERROR: %s → %v (expected %v)", "SliceInsertSortedFn", s, expected)
+	}
+
+	if s := SliceInsertSortedFn(S(3, 1), 2, nil); !SliceEqual(s, S(3, 1, 2)) {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceInsertSortedFn", s)
+	}
+}
+
+func TestSliceFlatMap(t *testing.T) {
+	repeat := func(v int) []int {
+		out := make([]int, 0, v)
+		for i := 0; i < v; i++ {
+			out = append(out, v)
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		a      []int
+		expect []int
+	}{
+		{nil, S[int]()},
+		{S(0), S[int]()},
+		{S(1, 0, 2, 3), S(1, 2, 2, 3, 3, 3)},
+	} {
+		s := SliceFlatMap(tc.a, repeat)
+		if s == nil || !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v) → %v (expected %v)", "SliceFlatMap", tc.a, s, tc.expect)
+		}
+	}
+
+	if s := SliceFlatMap[int, int](S(1), nil); s == nil || len(s) != 0 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceFlatMap", s)
+	}
+}
+
+func TestSliceTransform(t *testing.T) {
+	for _, tc := range []struct {
+		a      []int
+		expect []string
+	}{
+		{nil, nil},
+		{[]int{}, []string{}},
+		{S(1, 0, 2, 3), S("1", "0", "2", "3")},
+	} {
+		s := SliceTransform(tc.a, strconv.Itoa)
+		if (s == nil) != (tc.a == nil) || !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v) → %q (expected %q)", "SliceTransform", tc.a, s, tc.expect)
+		}
+	}
+
+	if s := SliceTransform[int, string](S(1), nil); s != nil {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceTransform", s)
+	}
+}
+
+func TestSliceReduce(t *testing.T) {
+	concat := func(acc string, v int) string {
+		return acc + strconv.Itoa(v)
+	}
+
+	for _, tc := range []struct {
+		a           []int
+		left, right string
+	}{
+		{nil, ">", ">"},
+		{S(1), ">1", ">1"},
+		{S(1, 2, 3), ">123", ">321"},
+	} {
+		if s := SliceReduce(tc.a, ">", concat); s != tc.left {
+			t.Errorf("ERROR: %s(%v) → %q (expected %q)", "SliceReduce", tc.a, s, tc.left)
+		}
+		if s := SliceReduceRight(tc.a, ">", concat); s != tc.right {
+			t.Errorf("ERROR: %s(%v) → %q (expected %q)", "SliceReduceRight", tc.a, s, tc.right)
+		}
+	}
+
+	if n := SliceReduce[int, int](S(1, 2), 7, nil); n != 7 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceReduce", n)
+	}
+	if n := SliceReduceRight[int, int](S(1, 2), 7, nil); n != 7 {
+		t.Errorf("ERROR: %s(nil) → %v", "SliceReduceRight", n)
+	}
+}
+
+func TestSliceCopyRange(t *testing.T) {
+	a := S(1, 2, 3, 4, 5)
+
+	for _, tc := range []struct {
+		low, high int
+		expect    []int
+	}{
+		{0, 0, S[int]()},
+		{0, 5, S(1, 2, 3, 4, 5)},
+		{1, 3, S(2, 3)},
+		{5, 5, S[int]()},
+	} {
+		s := SliceCopyRange(a, tc.low, tc.high)
+		if !SliceEqual(s, tc.expect) {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)",
+				"SliceCopyRange", tc.low, tc.high, s, tc.expect)
+		} else if len(s) > 0 {
+			s[0] = -1
+			if a[tc.low] == -1 {
+				t.Errorf("ERROR: %s(%v, %v) shares memory", "SliceCopyRange", tc.low, tc.high)
+			}
+		}
+	}
+
+	for _, tc := range []struct{ low, high int }{
+		{-1, 2}, {3, 2}, {0, 6},
+	} {
+		err := Catch(func() error {
+			_ = SliceCopyRange(a, tc.low, tc.high)
+			return nil
+		})
+		if !errors.Is(err, ErrInvalid) {
+			t.Errorf("ERROR: %s(%v, %v) → %v (expected %v)",
+				"SliceCopyRange", tc.low, tc.high, err, ErrInvalid)
+		}
+	}
+}
+
 func TestSliceRandom(t *testing.T) {
 	tests := []struct {
 		name   string
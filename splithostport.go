@@ -10,11 +10,55 @@ import (
 	"golang.org/x/net/idna"
 )
 
+// HostPortOption alters how [MakeHostPortWith] produces
+// its host:port strings
+type HostPortOption func(*hostPortConfig)
+
+type hostPortConfig struct {
+	alwaysBracket bool
+}
+
+func newHostPortConfig(opts ...HostPortOption) *hostPortConfig {
+	cfg := new(hostPortConfig)
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	return cfg
+}
+
+// portlessIP returns the representation of a portless IP address
+func (cfg *hostPortConfig) portlessIP(ip netip.Addr) string {
+	if cfg.alwaysBracket {
+		return ipForHostPort(ip)
+	}
+	return ip.String()
+}
+
+// WithAlwaysBracket makes [MakeHostPortWith] keep IPv6 addresses
+// bracketed even when there is no port
+func WithAlwaysBracket() HostPortOption {
+	return func(cfg *hostPortConfig) {
+		cfg.alwaysBracket = true
+	}
+}
+
 // MakeHostPort produces a validated host:port from an input string
 // optionally using the given default port when the string doesn't
 // specify one.
 // port 0 on the string input isn't considered valid.
 func MakeHostPort(hostPort string, defaultPort uint16) (string, error) {
+	return MakeHostPortWith(hostPort, defaultPort)
+}
+
+// MakeHostPortWith produces a validated host:port like [MakeHostPort]
+// but allows the canonical form to be altered by the given options.
+func MakeHostPortWith(hostPort string, defaultPort uint16,
+	opts ...HostPortOption) (string, error) {
+	//
+	cfg := newHostPortConfig(opts...)
+
 	host, port, err := SplitHostPort(hostPort)
 	if err != nil {
 		// bad input
@@ -29,7 +73,7 @@ func MakeHostPort(hostPort string, defaultPort uint16) (string, error) {
 
 		if port == "" && defaultPort == 0 {
 			// portless IP
-			return ip.String(), nil
+			return cfg.portlessIP(ip), nil
 		}
 
 		host = ipForHostPort(ip)
@@ -61,6 +105,47 @@ func doMakeHostPort(host, port string, defaultPort uint16) (string, error) {
 	return hostPort, nil
 }
 
+// ResolveHostPort splits and validates a host:port string, optionally
+// using the given default port when the string doesn't specify one,
+// and returns the host, the numeric port, and the canonical host:port
+// ready to be dialed.
+// As with MakeHostPort, port 0 on the string input isn't considered
+// valid, and an error is returned if no port is known.
+//
+// revive:disable:function-result-limit
+func ResolveHostPort(hostPort string, defaultPort uint16) (host string, port uint16,
+	canonical string, err error) {
+	// revive:enable:function-result-limit
+	host, sPort, err := SplitHostPort(hostPort)
+	if err != nil {
+		// bad input
+		return "", 0, "", err
+	}
+
+	switch {
+	case sPort == "":
+		port = defaultPort
+	default:
+		// already validated
+		port, _ = parsePort(sPort)
+		if port == 0 {
+			return "", 0, "", addrErr(hostPort, "invalid port")
+		}
+	}
+
+	if port == 0 {
+		return "", 0, "", addrErr(hostPort, "missing port")
+	}
+
+	canonical = host
+	if ip, _ := ParseAddr(host); ip.IsValid() {
+		canonical = ipForHostPort(ip)
+	}
+	canonical += ":" + strconv.FormatUint(uint64(port), 10)
+
+	return host, port, canonical, nil
+}
+
 // JoinHostPort is like the standard net.JoinHostPort, but
 // it validates the host name and port, and returns it portless
 // if the port argument is empty.
@@ -133,6 +218,46 @@ func SplitHostPort(hostPort string) (host, port string, err error) {
 	}
 }
 
+// SplitHostPortNumeric is like SplitHostPort but returns the port
+// as a number, which will be zero if the port isn't part of the string.
+// As with MakeHostPort, port 0 on the string input isn't considered
+// valid.
+func SplitHostPortNumeric(hostPort string) (host string, port uint16, err error) {
+	host, sPort, err := SplitHostPort(hostPort)
+	switch {
+	case err != nil:
+		// bad input
+		return "", 0, err
+	case sPort != "":
+		// already validated
+		port, _ = parsePort(sPort)
+		if port == 0 {
+			return "", 0, addrErr(hostPort, "invalid port")
+		}
+	}
+
+	return host, port, nil
+}
+
+// HostPortEqual tells if two host:port strings refer to the same
+// endpoint once normalised, considering host name casing and IDN,
+// IP address representation, IPv6 brackets and port numbers.
+// A portless input only equals another portless input, and invalid
+// inputs, including those with an explicit port 0, are never equal.
+func HostPortEqual(a, b string) bool {
+	hostA, portA, err := SplitHostPortNumeric(a)
+	if err != nil {
+		return false
+	}
+
+	hostB, portB, err := SplitHostPortNumeric(b)
+	if err != nil {
+		return false
+	}
+
+	return portA == portB && strings.EqualFold(hostA, hostB)
+}
+
 // SplitAddrPort splits a string containing an IP address and an optional port,
 // and validates it.
 func SplitAddrPort(addrPort string) (addr netip.Addr, port uint16, err error) {
@@ -99,3 +99,143 @@ func TestSplitHostPort(t *testing.T) {
 		}
 	}
 }
+
+type splitHostPortNumericCase struct {
+	hostport string
+	host     string
+	port     uint16
+	ok       bool
+}
+
+func TestSplitHostPortNumeric(t *testing.T) {
+	var cases = []splitHostPortNumericCase{
+		{"", "", 0, false},                                       // nothing                       BAD
+		{"name", "name", 0, true},                                // host and no port              OK
+		{"name:1234", "name", 1234, true},                        // simple host and port          OK
+		{"name:0", "", 0, false},                                 // explicit port 0               BAD
+		{"name:000", "", 0, false},                               // explicit port 0               BAD
+		{"name:port", "", 0, false},                              // host but bad port             BAD
+		{"name:123456", "", 0, false},                            // port out of range             BAD
+		{"bad name:80", "", 0, false},                            // bad host                      BAD
+		{"0.0.0.0:6060", "0.0.0.0", 6060, true},                  // unspecified IPv4 and port     OK
+		{"::1", "::1", 0, true},                                  // IPv6 and no port              OK
+		{"[::1]:1234", "::1", 1234, true},                        // bracketed IPv6 and port       OK
+		{"[::1]:", "", 0, false},                                 // bracketed IPv6 and empty port BAD
+		{"hello.xn--rhqv96g:80", "hello.\u4E16\u754C", 80, true}, // puny code and port           OK
+	}
+
+	for _, d := range cases {
+		h, p, err := SplitHostPortNumeric(d.hostport)
+		if h != d.host || p != d.port || (err == nil) != d.ok {
+			t.Errorf("%sSplitHostPortNumeric(%q) -> %q, %v, %#v",
+				"FAIL ", d.hostport, h, p, err)
+		} else {
+			t.Logf("%sSplitHostPortNumeric(%q) -> %q, %v, %#v",
+				"", d.hostport, h, p, err)
+		}
+	}
+}
+
+type makeHostPortCase struct {
+	hostport    string
+	defaultPort uint16
+	result      string
+	bracketed   string
+	ok          bool
+}
+
+func TestMakeHostPortWith(t *testing.T) {
+	var cases = []makeHostPortCase{
+		{"", 80, "", "", false},                              // nothing                   BAD
+		{"name:0", 80, "", "", false},                        // port 0                    BAD
+		{"name", 0, "name", "name", true},                    // portless name             OK
+		{"name", 80, "name:80", "name:80", true},             // default port              OK
+		{"127.0.0.1", 0, "127.0.0.1", "127.0.0.1", true},     // portless IPv4             OK
+		{"::1", 0, "::1", "[::1]", true},                     // portless IPv6             OK
+		{"[::1]", 0, "::1", "[::1]", true},                   // portless bracketed IPv6   OK
+		{"::1", 80, "[::1]:80", "[::1]:80", true},            // IPv6 and default port     OK
+		{"[::1]:8080", 80, "[::1]:8080", "[::1]:8080", true}, // bracketed IPv6 and port   OK
+	}
+
+	for _, d := range cases {
+		s, err := MakeHostPort(d.hostport, d.defaultPort)
+		if s != d.result || (err == nil) != d.ok {
+			t.Errorf("%sMakeHostPort(%q, %v) -> %q, %#v",
+				"FAIL ", d.hostport, d.defaultPort, s, err)
+		}
+
+		s, err = MakeHostPortWith(d.hostport, d.defaultPort, WithAlwaysBracket())
+		if s != d.bracketed || (err == nil) != d.ok {
+			t.Errorf("%sMakeHostPortWith(%q, %v, WithAlwaysBracket()) -> %q, %#v",
+				"FAIL ", d.hostport, d.defaultPort, s, err)
+		}
+	}
+}
+
+type resolveHostPortCase struct {
+	hostport    string
+	defaultPort uint16
+	host        string
+	port        uint16
+	canonical   string
+	ok          bool
+}
+
+func TestResolveHostPort(t *testing.T) {
+	var cases = []resolveHostPortCase{
+		{"", 80, "", 0, "", false},                               // nothing                   BAD
+		{"name", 0, "", 0, "", false},                            // no port and no default    BAD
+		{"name:0", 80, "", 0, "", false},                         // port 0                    BAD
+		{"name:port", 80, "", 0, "", false},                      // bad port                  BAD
+		{"name", 80, "name", 80, "name:80", true},                // default port              OK
+		{"name:8080", 80, "name", 8080, "name:8080", true},       // explicit port             OK
+		{"127.0.0.1", 80, "127.0.0.1", 80, "127.0.0.1:80", true}, // IPv4 and default port     OK
+		{"::1", 80, "::1", 80, "[::1]:80", true},                 // IPv6 and default port     OK
+		{"[::1]:8080", 80, "::1", 8080, "[::1]:8080", true},      // bracketed IPv6 and port   OK
+	}
+
+	for _, d := range cases {
+		h, p, s, err := ResolveHostPort(d.hostport, d.defaultPort)
+		if h != d.host || p != d.port || s != d.canonical || (err == nil) != d.ok {
+			t.Errorf("%sResolveHostPort(%q, %v) -> %q, %v, %q, %#v",
+				"FAIL ", d.hostport, d.defaultPort, h, p, s, err)
+		} else {
+			t.Logf("%sResolveHostPort(%q, %v) -> %q, %v, %q, %#v",
+				"", d.hostport, d.defaultPort, h, p, s, err)
+		}
+	}
+}
+
+type hostPortEqualCase struct {
+	a, b  string
+	equal bool
+}
+
+func TestHostPortEqual(t *testing.T) {
+	var cases = []hostPortEqualCase{
+		{"localhost:80", "localhost:80", true},            // identical                     OK
+		{"LOCALHOST:80", "localhost:80", true},            // host casing                   OK
+		{"localhost:80", "localhost:080", true},           // port representation           OK
+		{"localhost:80", "localhost:8080", false},         // different port                OK
+		{"localhost", "localhost", true},                  // portless                      OK
+		{"localhost", "localhost:80", false},              // portless vs port              OK
+		{"[::1]:8080", "[0:0::1]:8080", true},             // IPv6 representation           OK
+		{"::1", "[::1]", true},                            // IPv6 brackets                 OK
+		{"127.0.0.1:80", "localhost:80", false},           // IP vs name                    OK
+		{"hello.xn--rhqv96g", "Hello.\u4E16\u754C", true}, // puny code                     OK
+		{"bad name:80", "bad name:80", false},             // invalid                       BAD
+		{"localhost:0", "localhost:000", false},           // explicit port 0               BAD
+		{"localhost:0", "localhost", false},               // explicit port 0 vs portless   BAD
+		{"", "", false},                                   // empty                         BAD
+	}
+
+	for _, d := range cases {
+		if r := HostPortEqual(d.a, d.b); r != d.equal {
+			t.Errorf("%sHostPortEqual(%q, %q) -> %v",
+				"FAIL ", d.a, d.b, r)
+		} else if r2 := HostPortEqual(d.b, d.a); r2 != r {
+			t.Errorf("%sHostPortEqual(%q, %q) -> %v (not symmetric)",
+				"FAIL ", d.b, d.a, r2)
+		}
+	}
+}
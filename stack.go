@@ -1,6 +1,7 @@
 package core
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"path"
@@ -56,6 +57,11 @@ func frameForPC(pc uintptr) Frame {
 	}
 }
 
+// IsZero reports whether the Frame doesn't describe any function call.
+func (f Frame) IsZero() bool {
+	return f.name == "" && f.file == "" && f.line == 0
+}
+
 // Name returns the name of the function,
 // including package name
 func (f Frame) Name() string {
@@ -86,6 +92,56 @@ func (f Frame) SplitName() (pkgName string, funcName string) {
 	return f.name[:i], f.name[i+1:]
 }
 
+// ShortName returns the name of the function, without the
+// package name, and with any list of generic type parameters
+// reduced to `[...]`
+func (f Frame) ShortName() string {
+	name, generic := strings.CutSuffix(trimGenericParams(f.name), "[...]")
+	if i := strings.LastIndexAny(name, "./"); i >= 0 {
+		name = name[i+1:]
+	}
+	if generic {
+		name += "[...]"
+	}
+	return name
+}
+
+// trimGenericParams replaces the content of any top-level
+// bracket in the name with `...`
+func trimGenericParams(name string) string {
+	i := strings.IndexByte(name, '[')
+	if i < 0 {
+		return name
+	}
+
+	j := closingBracket(name, i)
+	if j < 0 {
+		// unbalanced
+		return name
+	}
+
+	return name[:i] + "[...]" + trimGenericParams(name[j+1:])
+}
+
+// closingBracket returns the index of the ']' matching
+// the '[' at the given position, or -1 if there is none
+func closingBracket(s string, start int) int {
+	var depth int
+
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // File returns the file name of the source code
 // corresponding to this Frame
 func (f Frame) File() string {
@@ -171,6 +227,31 @@ func writeFormat(s io.Writer, str string) {
 	}
 }
 
+// LogFields returns the Frame as a map of fields, "func", "file"
+// and "line", for structured loggers.
+func (f Frame) LogFields() map[string]any {
+	return map[string]any{
+		"func": f.Name(),
+		"file": f.File(),
+		"line": f.Line(),
+	}
+}
+
+// MarshalJSON encodes the Frame as an object with the fields
+// "func", "file" and "line", as in [Frame.LogFields].
+// A zero Frame encodes empty strings and a zero line.
+func (f Frame) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Func string `json:"func"`
+		File string `json:"file"`
+		Line int    `json:"line"`
+	}{
+		Func: f.Name(),
+		File: f.File(),
+		Line: f.Line(),
+	})
+}
+
 // Stack is an snapshot of the call stack in
 // the form of an array of Frames.
 type Stack []Frame
@@ -196,6 +277,87 @@ func (st Stack) Format(s fmt.State, verb rune) {
 	}
 }
 
+// LogFields returns the Frames of the Stack as maps of fields
+// for structured loggers. See [Frame.LogFields].
+func (st Stack) LogFields() []map[string]any {
+	out := make([]map[string]any, 0, len(st))
+	for _, f := range st {
+		out = append(out, f.LogFields())
+	}
+	return out
+}
+
+// IsEmpty tells if the Stack doesn't contain any Frame
+func (st Stack) IsEmpty() bool {
+	return len(st) == 0
+}
+
+// MarshalJSON encodes the Stack as an array of Frames,
+// empty if the Stack is empty. See [Frame.MarshalJSON].
+func (st Stack) MarshalJSON() ([]byte, error) {
+	frames := []Frame(st)
+	if frames == nil {
+		frames = []Frame{}
+	}
+	return json.Marshal(frames)
+}
+
+// At returns the Frame at the given position of the Stack,
+// or false if it's out of range
+func (st Stack) At(i int) (Frame, bool) {
+	if i < 0 || i >= len(st) {
+		return Frame{}, false
+	}
+	return st[i], true
+}
+
+// Top returns the first Frame of the Stack, or false
+// if it's empty
+func (st Stack) Top() (Frame, bool) {
+	return st.At(0)
+}
+
+// Filter returns a new Stack containing only the Frames
+// satisfying the given condition. If no condition is given
+// the result is a copy of the Stack.
+func (st Stack) Filter(cond func(Frame) bool) Stack {
+	if st == nil {
+		return nil
+	}
+
+	out := make(Stack, 0, len(st))
+	for _, f := range st {
+		if cond == nil || cond(f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// FilterRuntime returns a new Stack without the Frames
+// belonging to the runtime, testing and reflect packages,
+// including methods of their types.
+func (st Stack) FilterRuntime() Stack {
+	return st.Filter(func(f Frame) bool {
+		switch framePkgPath(f.name) {
+		case "runtime", "testing", "reflect":
+			return false
+		default:
+			return true
+		}
+	})
+}
+
+// framePkgPath returns the import path of the package
+// of a function name, without receiver or function name
+func framePkgPath(name string) string {
+	i := strings.LastIndexByte(name, '/') + 1
+	if j := strings.IndexByte(name[i:], '.'); j >= 0 {
+		return name[:i+j]
+	}
+	return name
+}
+
 // Here returns the Frame corresponding to where it was called,
 // or nil if it wasn't possible
 func Here() *Frame {
@@ -245,3 +407,52 @@ func StackTrace(skip int) Stack {
 
 	return st
 }
+
+// StackTraceN returns a snapshot of the call stack like [StackTrace]
+// but stopping after collecting the given number of Frames.
+// A maximum of zero or less, or above [MaxDepth], means [MaxDepth].
+func StackTraceN(skip, maxFrames int) Stack {
+	if maxFrames <= 0 || maxFrames > MaxDepth {
+		maxFrames = MaxDepth
+	}
+
+	var st Stack
+
+	pcs := make([]uintptr, maxFrames)
+	if n := runtime.Callers(2+skip, pcs); n > 0 {
+		st = make(Stack, 0, n)
+		for _, pc := range pcs[:n] {
+			st = append(st, frameForPC(pc))
+		}
+	}
+
+	return st
+}
+
+// StackTraceFrom returns a snapshot of the call stack starting
+// at the first frame whose package is the given one or one of its
+// sub-packages, or an empty Stack if there is none.
+func StackTraceFrom(pkgPrefix string) Stack {
+	st := StackTrace(1)
+	for i, f := range st {
+		if hasPkgPrefix(f.PkgName(), pkgPrefix) {
+			return st[i:]
+		}
+	}
+	return nil
+}
+
+// hasPkgPrefix tells if a package name starts with the given
+// prefix followed by a path boundary, so "darvaza.org/core"
+// matches "darvaza.org/core/x" but not "darvaza.org/corex".
+func hasPkgPrefix(pkgName, prefix string) bool {
+	rest, ok := strings.CutPrefix(pkgName, prefix)
+	switch {
+	case !ok:
+		return false
+	case prefix == "", rest == "":
+		return true
+	default:
+		return rest[0] == '/' || rest[0] == '.'
+	}
+}
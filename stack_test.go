@@ -1,8 +1,10 @@
 package core
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 )
 
@@ -125,3 +127,286 @@ func checkDeepStackTrace(stack Stack, depth int) bool {
 	}
 	return true
 }
+
+func TestStackAtEmpty(t *testing.T) {
+	var empty Stack
+
+	if _, ok := empty.Top(); ok {
+		t.Errorf("Stack.Top() on empty stack")
+	}
+	if _, ok := empty.At(0); ok {
+		t.Errorf("Stack.At(0) on empty stack")
+	}
+}
+
+func TestStackAt(t *testing.T) {
+	stack := StackTrace(0)
+	if f, ok := stack.Top(); !ok || f.FuncName() != "TestStackAt" {
+		t.Errorf("Stack.Top(): %n, %v", f, ok)
+	}
+
+	l := len(stack)
+	for _, i := range []int{-1, l, l + 1} {
+		if _, ok := stack.At(i); ok {
+			t.Errorf("Stack.At(%v) out of range [0, %v)", i, l)
+		}
+	}
+
+	if f, ok := stack.At(l - 1); !ok || f != stack[l-1] {
+		t.Errorf("Stack.At(%v): %n, %v", l-1, f, ok)
+	}
+}
+
+func TestFrameShortName(t *testing.T) {
+	for _, tc := range []struct{ name, short string }{
+		{"", ""},
+		{"main.main", "main"},
+		{"darvaza.org/core.SliceMap[...]", "SliceMap[...]"},
+		{"darvaza.org/core.SliceMap[go.shape.int,go.shape.string]", "SliceMap[...]"},
+		{"example.com/pkg.Foo[map[string]int]", "Foo[...]"},
+		{"example.com/pkg.Foo[...].func1", "func1"},
+		{"darvaza.org/core.(*sortable[...]).Len", "Len"},
+		{"darvaza.org/core.(*sortable[go.shape.int]).Len", "Len"},
+		{"example.com/pkg.Foo[int].Bar[string]", "Bar[...]"},
+		{"example.com/pkg.Foo[int", "Foo[int"},
+	} {
+		f := Frame{name: tc.name}
+		if s := f.ShortName(); s != tc.short {
+			t.Errorf("Frame{%q}.ShortName() → %q (expected %q)", tc.name, s, tc.short)
+		}
+	}
+}
+
+func TestStackLogFields(t *testing.T) {
+	stack := StackTrace(0)
+	fields := stack.LogFields()
+	if len(fields) != len(stack) {
+		t.Fatalf("Stack.LogFields(): %v entries, expected %v", len(fields), len(stack))
+	}
+
+	f := stack[0]
+	m := fields[0]
+	if m["func"] != f.Name() || m["file"] != f.File() || m["line"] != f.Line() {
+		t.Errorf("Stack.LogFields(): %v doesn't match %+v", m, f)
+	}
+
+	var empty Stack
+	if fields := empty.LogFields(); len(fields) != 0 {
+		t.Errorf("Stack.LogFields(): %v on empty stack", fields)
+	}
+}
+
+func TestStackTraceFrom(t *testing.T) {
+	stack := StackTraceFrom("darvaza.org/core")
+	if f, ok := stack.Top(); !ok || f.FuncName() != "TestStackTraceFrom" {
+		t.Errorf("StackTraceFrom(%q): %n", "darvaza.org/core", stack)
+	}
+
+	stack = StackTraceFrom("testing")
+	if f, ok := stack.Top(); !ok || f.PkgName() != "testing" {
+		t.Errorf("StackTraceFrom(%q): %n", "testing", stack)
+	}
+
+	for _, prefix := range []string{"example.org/nowhere", "darvaza.org/cor", "testin"} {
+		if stack := StackTraceFrom(prefix); len(stack) != 0 {
+			t.Errorf("StackTraceFrom(%q): %n", prefix, stack)
+		}
+	}
+}
+
+func TestHasPkgPrefix(t *testing.T) {
+	for _, tc := range []struct {
+		name, prefix string
+		expect       bool
+	}{
+		{"darvaza.org/core", "darvaza.org/core", true},
+		{"darvaza.org/core/internal", "darvaza.org/core", true},
+		{"darvaza.org/core.(*Catcher)", "darvaza.org/core", true},
+		{"darvaza.org/corex", "darvaza.org/core", false},
+		{"darvaza.org/cor", "darvaza.org/core", false},
+		{"reflect.Value", "reflect", true},
+		{"reflect.Value", "", true},
+	} {
+		if r := hasPkgPrefix(tc.name, tc.prefix); r != tc.expect {
+			t.Errorf("hasPkgPrefix(%q, %q) → %v (expected %v)", tc.name, tc.prefix, r, tc.expect)
+		}
+	}
+}
+
+func TestStackFilter(t *testing.T) {
+	stack := StackTrace(0)
+
+	if s := stack.Filter(nil); len(s) != len(stack) {
+		t.Errorf("Stack.Filter(nil): %n", s)
+	}
+	if s := stack.Filter(func(Frame) bool { return false }); s == nil || len(s) != 0 {
+		t.Errorf("Stack.Filter(): %n", s)
+	}
+
+	var empty Stack
+	if s := empty.FilterRuntime(); s != nil {
+		t.Errorf("Stack.FilterRuntime(): %n on empty stack", s)
+	}
+}
+
+func TestStackFilterRuntime(t *testing.T) {
+	stack := StackTrace(0)
+	filtered := testStackFilterRuntime(t, stack)
+	if f, ok := filtered.Top(); !ok || f.FuncName() != "TestStackFilterRuntime" {
+		t.Errorf("Stack.FilterRuntime(): %n", filtered)
+	}
+}
+
+func TestStackFilterRuntimeReflect(t *testing.T) {
+	var stack Stack
+	reflect.ValueOf(func() {
+		stack = StackTrace(0)
+	}).Call(nil)
+
+	if !SliceAny(stack, func(f Frame) bool { return f.PkgName() == "reflect.Value" }) {
+		t.Fatalf("StackTrace(): no reflect.Value frames in %n", stack)
+	}
+	testStackFilterRuntime(t, stack)
+}
+
+// testStackFilterRuntime checks FilterRuntime removed some
+// frames and none of the remaining belongs to the runtime,
+// testing or reflect packages.
+func testStackFilterRuntime(t *testing.T, stack Stack) Stack {
+	filtered := stack.FilterRuntime()
+	if len(filtered) >= len(stack) {
+		t.Errorf("Stack.FilterRuntime(): nothing removed from %n", stack)
+	}
+
+	for _, f := range filtered {
+		switch framePkgPath(f.Name()) {
+		case "runtime", "testing", "reflect":
+			t.Errorf("Stack.FilterRuntime(): %+n not removed", f)
+		}
+	}
+	return filtered
+}
+
+func TestFrameIsZero(t *testing.T) {
+	var zero Frame
+	if !zero.IsZero() || !IsZero(zero) {
+		t.Errorf("Frame{}.IsZero(): false")
+	}
+
+	if f := Here(); f == nil || f.IsZero() || IsZero(f) {
+		t.Errorf("Here().IsZero(): %+v", f)
+	}
+
+	for _, f := range []Frame{{name: "main.main"}, {file: "main.go"}, {line: 1}} {
+		if f.IsZero() {
+			t.Errorf("%#v.IsZero(): true", f)
+		}
+	}
+}
+
+func TestStackIsEmpty(t *testing.T) {
+	var empty Stack
+	if !empty.IsEmpty() || !(Stack{}).IsEmpty() {
+		t.Errorf("Stack.IsEmpty(): false on empty stack")
+	}
+	if StackTrace(0).IsEmpty() {
+		t.Errorf("Stack.IsEmpty(): true on StackTrace(0)")
+	}
+}
+
+func TestStackTraceN(t *testing.T) {
+	full := StackTrace(0)
+
+	stack := StackTraceN(0, 1)
+	if f, ok := stack.Top(); len(stack) != 1 || !ok || f.FuncName() != "TestStackTraceN" {
+		t.Errorf("StackTraceN(0, 1): %n", stack)
+	}
+
+	stack = StackTraceN(1, 2)
+	if len(stack) != 2 || stack[0].Name() != full[1].Name() || stack[1].Name() != full[2].Name() {
+		t.Errorf("StackTraceN(1, 2): %n", stack)
+	}
+
+	if stack := StackTraceN(len(full)+10, 1); !stack.IsEmpty() {
+		t.Errorf("StackTraceN(%v, 1): %n", len(full)+10, stack)
+	}
+}
+
+func TestStackTraceNUnlimited(t *testing.T) {
+	full := StackTrace(0)
+
+	for _, n := range []int{0, -1, len(full) + 10, MaxDepth + 1, 1 << 30} {
+		stack := StackTraceN(0, n)
+		if len(stack) != len(full) || stack[0].Name() != full[0].Name() {
+			t.Errorf("StackTraceN(0, %v): %n", n, stack)
+		}
+	}
+}
+
+func TestFrameMarshalJSON(t *testing.T) {
+	f := Frame{name: "darvaza.org/core.Foo", file: "/src/foo.go", line: 42}
+	expected := `{"func":"darvaza.org/core.Foo","file":"/src/foo.go","line":42}`
+	if b, err := json.Marshal(f); err != nil || string(b) != expected {
+		t.Errorf("Frame.MarshalJSON(): %s, %v (expected %s)", b, err, expected)
+	}
+
+	expected = `{"func":"","file":"","line":0}`
+	if b, err := json.Marshal(Frame{}); err != nil || string(b) != expected {
+		t.Errorf("Frame{}.MarshalJSON(): %s, %v (expected %s)", b, err, expected)
+	}
+}
+
+func TestStackMarshalJSON(t *testing.T) {
+	stack := StackTrace(0)
+
+	b, err := json.Marshal(stack)
+	if err != nil {
+		t.Fatalf("Stack.MarshalJSON(): %v", err)
+	}
+
+	var out []map[string]any
+	if err := json.Unmarshal(b, &out); err != nil || len(out) != len(stack) {
+		t.Fatalf("Stack.MarshalJSON(): %s, %v", b, err)
+	}
+	if out[0]["func"] != stack[0].Name() || out[0]["line"] != float64(stack[0].Line()) {
+		t.Errorf("Stack.MarshalJSON(): %v doesn't match %+v", out[0], stack[0])
+	}
+
+	var empty Stack
+	if b, err := json.Marshal(empty); err != nil || string(b) != "[]" {
+		t.Errorf("Stack.MarshalJSON(): %s, %v on empty stack", b, err)
+	}
+}
+
+func TestFramePkgPath(t *testing.T) {
+	for _, tc := range []struct{ name, path string }{
+		{"", ""},
+		{"main.main", "main"},
+		{"runtime.goexit", "runtime"},
+		{"reflect.Value.Call", "reflect"},
+		{"testing.(*T).Run", "testing"},
+		{"darvaza.org/core.SliceMap[...]", "darvaza.org/core"},
+		{"darvaza.org/core.(*Catcher).Try.func1", "darvaza.org/core"},
+		{"example.com/pkg.v2/sub.Foo", "example.com/pkg.v2/sub"},
+	} {
+		if s := framePkgPath(tc.name); s != tc.path {
+			t.Errorf("framePkgPath(%q) → %q (expected %q)", tc.name, s, tc.path)
+		}
+	}
+}
+
+func deepStackTraceN(depth, maxFrames int) Stack {
+	if depth > 0 {
+		return deepStackTraceN(depth-1, maxFrames)
+	}
+	return StackTraceN(0, maxFrames)
+}
+
+func TestStackTraceNMaxDepth(t *testing.T) {
+	for _, n := range []int{0, MaxDepth, MaxDepth + 1, 1 << 30} {
+		stack := deepStackTraceN(2*MaxDepth, n)
+		if len(stack) != MaxDepth {
+			t.Errorf("StackTraceN(0, %v): %v frames (expected %v)", n, len(stack), MaxDepth)
+		}
+	}
+}
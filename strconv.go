@@ -0,0 +1,87 @@
+package core
+
+import (
+	"strconv"
+)
+
+// MustInt parses a decimal string as an int, and panics
+// with a [PanicError] if it fails.
+func MustInt(s string) int {
+	return mustParse(parseInt, s)
+}
+
+// MaybeInt parses a decimal string as an int, and returns
+// the given default if it fails.
+func MaybeInt(s string, def int) int {
+	return maybeParse(parseInt, s, def)
+}
+
+// MustInt64 parses a decimal string as an int64, and panics
+// with a [PanicError] if it fails.
+func MustInt64(s string) int64 {
+	return mustParse(parseInt64, s)
+}
+
+// MaybeInt64 parses a decimal string as an int64, and returns
+// the given default if it fails.
+func MaybeInt64(s string, def int64) int64 {
+	return maybeParse(parseInt64, s, def)
+}
+
+// MustUint parses a decimal string as an uint, and panics
+// with a [PanicError] if it fails.
+func MustUint(s string) uint {
+	return mustParse(parseUint, s)
+}
+
+// MaybeUint parses a decimal string as an uint, and returns
+// the given default if it fails.
+func MaybeUint(s string, def uint) uint {
+	return maybeParse(parseUint, s, def)
+}
+
+// MustUint64 parses a decimal string as an uint64, and panics
+// with a [PanicError] if it fails.
+func MustUint64(s string) uint64 {
+	return mustParse(parseUint64, s)
+}
+
+// MaybeUint64 parses a decimal string as an uint64, and returns
+// the given default if it fails.
+func MaybeUint64(s string, def uint64) uint64 {
+	return maybeParse(parseUint64, s, def)
+}
+
+func mustParse[T any](parse func(string) (T, error), s string) T {
+	v, err := parse(s)
+	if err != nil {
+		// skip mustParse and its caller
+		panic(NewPanicError(2, err))
+	}
+	return v
+}
+
+func maybeParse[T any](parse func(string) (T, error), s string, def T) T {
+	if v, err := parse(s); err == nil {
+		return v
+	}
+	return def
+}
+
+func parseInt(s string) (int, error) {
+	v, err := strconv.ParseInt(s, 10, 0)
+	return int(v), err
+}
+
+func parseInt64(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func parseUint(s string) (uint, error) {
+	v, err := strconv.ParseUint(s, 10, 0)
+	return uint(v), err
+}
+
+func parseUint64(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+type parseIntCase struct {
+	s   string
+	v   int64
+	err error
+}
+
+func TestMustMaybeInt(t *testing.T) {
+	const def = 42
+
+	for _, tc := range []parseIntCase{
+		{"0", 0, nil},
+		{"123", 123, nil},
+		{"-123", -123, nil},
+		{"", def, strconv.ErrSyntax},
+		{"abc", def, strconv.ErrSyntax},
+		{"12a", def, strconv.ErrSyntax},
+		{"0x10", def, strconv.ErrSyntax},
+		{"9223372036854775808", def, strconv.ErrRange},
+	} {
+		if v := MaybeInt64(tc.s, def); v != tc.v {
+			t.Errorf("MaybeInt64(%q) → %v (expected %v)", tc.s, v, tc.v)
+		}
+
+		testMustInt64(t, tc)
+	}
+}
+
+func testMustInt64(t *testing.T, tc parseIntCase) {
+	var v int64
+	err := Catch(func() error {
+		v = MustInt64(tc.s)
+		return nil
+	})
+
+	switch {
+	case tc.err == nil && err != nil:
+		t.Errorf("MustInt64(%q) → %v", tc.s, err)
+	case tc.err == nil && v != tc.v:
+		t.Errorf("MustInt64(%q) → %v (expected %v)", tc.s, v, tc.v)
+	case tc.err != nil && !errors.Is(err, tc.err):
+		t.Errorf("MustInt64(%q) → %v (expected %v)", tc.s, err, tc.err)
+	}
+}
+
+func TestMustMaybeUint(t *testing.T) {
+	const def = 42
+
+	for _, tc := range []parseIntCase{
+		{"0", 0, nil},
+		{"123", 123, nil},
+		{"-123", def, strconv.ErrSyntax},
+		{"", def, strconv.ErrSyntax},
+		{"abc", def, strconv.ErrSyntax},
+		{"18446744073709551616", def, strconv.ErrRange},
+	} {
+		if v := MaybeUint64(tc.s, def); v != uint64(tc.v) {
+			t.Errorf("MaybeUint64(%q) → %v (expected %v)", tc.s, v, tc.v)
+		}
+
+		err := Catch(func() error {
+			_ = MustUint64(tc.s)
+			return nil
+		})
+		if (tc.err == nil) != (err == nil) || !errors.Is(err, tc.err) {
+			t.Errorf("MustUint64(%q) → %v (expected %v)", tc.s, err, tc.err)
+		}
+	}
+}
+
+func TestMustIntPanicError(t *testing.T) {
+	err := Catch(func() error {
+		_ = MustInt("abc")
+		return nil
+	})
+
+	p, ok := err.(*PanicError)
+	if !ok {
+		t.Fatalf("MustInt: expected *PanicError, got %#v", err)
+	}
+
+	if f, ok := p.CallStack().Top(); !ok || f.FuncName() != "func1" {
+		t.Errorf("MustInt: unexpected call stack: %+v", p.CallStack())
+	}
+
+	if v := MaybeInt("abc", -1); v != -1 {
+		t.Errorf("MaybeInt: expected %v, got %v", -1, v)
+	}
+	if v := MaybeUint("7", 0); v != 7 {
+		t.Errorf("MaybeUint: expected %v, got %v", 7, v)
+	}
+	if v := MustUint("7"); v != 7 {
+		t.Errorf("MustUint: expected %v, got %v", 7, v)
+	}
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"io"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -36,6 +37,40 @@ func (sl *SpinLock) Unlock() {
 	}
 }
 
+var (
+	_ io.Closer = (*Closer)(nil)
+)
+
+// Closer is an [io.Closer] that calls a function only once,
+// returning the same error on subsequent calls. It's safe for
+// concurrent use.
+type Closer struct {
+	once sync.Once
+	fn   func() error
+	err  error
+}
+
+// NewCloser creates a [Closer] using the given function.
+// A nil function is treated as a no-op.
+func NewCloser(fn func() error) *Closer {
+	return &Closer{fn: fn}
+}
+
+// Close calls the function the first time, and returns
+// its error, or the caught panic, every time
+func (c *Closer) Close() error {
+	if c == nil {
+		return ErrNilReceiver
+	}
+
+	c.once.Do(func() {
+		if c.fn != nil {
+			c.err = Catch(c.fn)
+		}
+	})
+	return c.err
+}
+
 // WaitGroup is a safer way to run workers
 type WaitGroup struct {
 	mu      sync.Mutex
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCloser(t *testing.T) {
+	const workers = 16
+
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+
+	errClosed := errors.New("closed")
+	c := NewCloser(func() error {
+		calls.Add(1)
+		return errClosed
+	})
+
+	errs := make([]error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = c.Close()
+		}(i)
+	}
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("Closer: function called %v times", n)
+	}
+
+	for i, err := range errs {
+		if err != errClosed {
+			t.Errorf("Closer: [%v] expected %v, got %v", i, errClosed, err)
+		}
+	}
+
+	if err := c.Close(); err != errClosed {
+		t.Errorf("Closer: expected %v, got %v", errClosed, err)
+	}
+}
+
+func TestCloserNil(t *testing.T) {
+	var nilCloser *Closer
+
+	if err := nilCloser.Close(); err != ErrNilReceiver {
+		t.Errorf("Closer: expected %v, got %v", ErrNilReceiver, err)
+	}
+
+	if err := NewCloser(nil).Close(); err != nil {
+		t.Errorf("Closer: unexpected error %v", err)
+	}
+}
@@ -34,3 +34,30 @@ func IsZero(vi any) bool {
 		return true
 	}
 }
+
+// ZeroNilReport tells if a value is nil, and if it's zero, in
+// a single pass. nil interfaces and nil values of nillable kinds,
+// like pointers, maps, slices, channels and functions, are both nil
+// and zero. Otherwise zero is determined as by [IsZero].
+func ZeroNilReport(vi any) (isNil, isZero bool) {
+	if vi == nil {
+		return true, true
+	}
+
+	v := reflect.ValueOf(vi)
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map,
+		reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		if v.IsNil() {
+			return true, true
+		}
+	}
+
+	if p, ok := vi.(interface {
+		IsZero() bool
+	}); ok {
+		return false, p.IsZero()
+	}
+
+	return false, v.IsZero()
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+type zeroNilCase struct {
+	name   string
+	value  any
+	isNil  bool
+	isZero bool
+}
+
+func TestZeroNilReport(t *testing.T) {
+	var cases = []zeroNilCase{
+		{"nil", nil, true, true},
+		{"nil pointer", (*int)(nil), true, true},
+		{"nil slice", []int(nil), true, true},
+		{"nil map", map[string]int(nil), true, true},
+		{"nil chan", (chan int)(nil), true, true},
+		{"nil func", (func())(nil), true, true},
+		{"nil error pointer", (*PanicError)(nil), true, true},
+		{"empty slice", []int{}, false, false},
+		{"empty map", map[string]int{}, false, false},
+		{"pointer to zero", new(int), false, false},
+		{"zero int", 0, false, true},
+		{"int", 1, false, false},
+		{"empty string", "", false, true},
+		{"string", "x", false, false},
+		{"empty struct", struct{}{}, false, true},
+		{"zero time", time.Time{}, false, true},
+		{"time", time.Unix(1, 0), false, false},
+	}
+
+	for _, tc := range cases {
+		isNil, isZero := ZeroNilReport(tc.value)
+		if isNil != tc.isNil || isZero != tc.isZero {
+			t.Errorf("ZeroNilReport: %s → %v, %v (expected %v, %v)",
+				tc.name, isNil, isZero, tc.isNil, tc.isZero)
+		}
+
+		if z := IsZero(tc.value); z != isZero {
+			t.Errorf("ZeroNilReport: %s → %v, but IsZero → %v", tc.name, isZero, z)
+		}
+	}
+}